@@ -0,0 +1,5 @@
+data "sendgrid_account_scopes" "example" {}
+
+output "missing_scopes" {
+  value = setsubtract(["mail.send", "stats.read"], data.sendgrid_account_scopes.example.scopes)
+}
@@ -0,0 +1,5 @@
+data "sendgrid_allowlist_rules" "example" {}
+
+output "ips" {
+  value = [for r in data.sendgrid_allowlist_rules.example.rules : r.ip]
+}
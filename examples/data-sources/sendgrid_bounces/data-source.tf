@@ -0,0 +1,5 @@
+data "sendgrid_bounces" "example" {}
+
+output "bounced_emails" {
+  value = [for b in data.sendgrid_bounces.example.bounces : b.email]
+}
@@ -0,0 +1,7 @@
+data "sendgrid_categories" "example" {
+  prefix = "newsletter"
+}
+
+output "newsletter_categories" {
+  value = data.sendgrid_categories.example.categories
+}
@@ -0,0 +1,11 @@
+data "sendgrid_custom_field" "example" {
+  name = "favorite_color"
+}
+
+output "type" {
+  value = data.sendgrid_custom_field.example.type
+}
+
+output "reserved" {
+  value = data.sendgrid_custom_field.example.reserved
+}
@@ -0,0 +1,11 @@
+data "sendgrid_stats" "example" {
+  start_date    = "2024-01-01"
+  end_date      = "2024-01-31"
+  aggregated_by = "week"
+  timezone      = "Asia/Tokyo"
+  cache_ttl     = "5m"
+}
+
+output "delivered" {
+  value = sum([for s in data.sendgrid_stats.example.stats : s.delivered])
+}
@@ -0,0 +1,8 @@
+data "sendgrid_teammate_invite" "example" {
+  email = "dummy@example.com"
+}
+
+output "accept_url" {
+  value     = data.sendgrid_teammate_invite.example.pending ? "https://app.sendgrid.com/settings/teammates/accept?token=${data.sendgrid_teammate_invite.example.token}" : null
+  sensitive = true
+}
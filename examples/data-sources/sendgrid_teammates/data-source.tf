@@ -0,0 +1,5 @@
+data "sendgrid_teammates" "example" {}
+
+output "admins" {
+  value = [for t in data.sendgrid_teammates.example.teammates : t.email if t.is_admin]
+}
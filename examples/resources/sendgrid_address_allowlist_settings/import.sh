@@ -0,0 +1 @@
+% terraform import sendgrid_address_allowlist_settings.example singleton
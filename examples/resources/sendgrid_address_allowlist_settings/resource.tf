@@ -0,0 +1,4 @@
+resource "sendgrid_address_allowlist_settings" "example" {
+  enabled = true
+  list    = ["alerts@example.com", "example.org"]
+}
@@ -0,0 +1 @@
+% terraform import sendgrid_allowlist_rules.example 1,2,3
@@ -0,0 +1,6 @@
+resource "sendgrid_allowlist_rules" "example" {
+  ips = [
+    "192.168.1.1",
+    "10.0.0.0/24",
+  ]
+}
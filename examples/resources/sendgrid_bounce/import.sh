@@ -0,0 +1 @@
+% terraform import sendgrid_bounce.example bounced@example.com
@@ -0,0 +1,3 @@
+resource "sendgrid_bounce" "example" {
+  email = "bounced@example.com"
+}
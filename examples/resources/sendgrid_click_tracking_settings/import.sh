@@ -1 +1 @@
-% terraform import sendgrid_click_tracking_settings.example ""
+% terraform import sendgrid_click_tracking_settings.example singleton
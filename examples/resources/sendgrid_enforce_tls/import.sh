@@ -1 +1 @@
-% terraform import sendgrid_enforce_tls.example ""
+% terraform import sendgrid_enforce_tls.example singleton
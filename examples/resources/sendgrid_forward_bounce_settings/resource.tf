@@ -0,0 +1,4 @@
+resource "sendgrid_forward_bounce_settings" "example" {
+  enabled = true
+  email   = "bounces@example.com"
+}
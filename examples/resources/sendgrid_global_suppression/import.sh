@@ -0,0 +1 @@
+% terraform import sendgrid_global_suppression.example unsubscribed@example.com
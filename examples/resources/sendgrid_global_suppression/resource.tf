@@ -0,0 +1,3 @@
+resource "sendgrid_global_suppression" "example" {
+  email = "unsubscribed@example.com"
+}
@@ -0,0 +1 @@
+% terraform import sendgrid_global_unsubscribe.example unsubscribed@example.com
@@ -0,0 +1,3 @@
+resource "sendgrid_global_unsubscribe" "example" {
+  email = "unsubscribed@example.com"
+}
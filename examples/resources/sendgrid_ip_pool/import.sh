@@ -0,0 +1 @@
+% terraform import sendgrid_ip_pool.example marketing
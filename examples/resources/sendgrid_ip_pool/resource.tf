@@ -0,0 +1,7 @@
+resource "sendgrid_ip_pool" "example" {
+  name = "marketing"
+  ips = [
+    "192.0.2.1",
+    "192.0.2.2",
+  ]
+}
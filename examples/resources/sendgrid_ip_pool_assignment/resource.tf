@@ -0,0 +1,8 @@
+resource "sendgrid_ip_pool" "example" {
+  name = "marketing"
+}
+
+resource "sendgrid_ip_pool_assignment" "example" {
+  pool_name = sendgrid_ip_pool.example.name
+  ip        = "192.0.2.1"
+}
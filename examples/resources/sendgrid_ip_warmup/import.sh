@@ -0,0 +1 @@
+% terraform import sendgrid_ip_warmup.example 192.0.2.1
@@ -0,0 +1,14 @@
+resource "sendgrid_ip_warmup" "example" {
+  ip = "192.0.2.1"
+}
+
+# Wait for a warmup that is about to end before switching traffic to the IP address.
+resource "sendgrid_ip_warmup" "waiting" {
+  ip                  = "192.0.2.2"
+  wait_for_completion = true
+
+  timeouts {
+    create = "2h"
+    update = "2h"
+  }
+}
@@ -0,0 +1 @@
+% terraform import sendgrid_spam_check_settings.example singleton
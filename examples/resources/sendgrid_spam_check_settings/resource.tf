@@ -0,0 +1,5 @@
+resource "sendgrid_spam_check_settings" "example" {
+  enabled   = true
+  url       = "https://example.com/spam"
+  max_score = 5
+}
@@ -1 +1 @@
-% terraform import sendgrid_teammate.example <teammate's email>
+% terraform import sendgrid_teammate.example <teammate's email or username>
@@ -0,0 +1 @@
+% terraform import sendgrid_usage_notification.example 123456789
@@ -0,0 +1,4 @@
+resource "sendgrid_usage_notification" "example" {
+  email_to   = "dummy@example.com"
+  percentage = 90
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/i10416/sendgrid"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &accountScopesDataSource{}
+	_ datasource.DataSourceWithConfigure = &accountScopesDataSource{}
+)
+
+// scopesLister lists the scopes granted to the API key in use.
+type scopesLister interface {
+	GetScopes(ctx context.Context) ([]string, error)
+}
+
+func newAccountScopesDataSource() datasource.DataSource {
+	return &accountScopesDataSource{}
+}
+
+type accountScopesDataSource struct {
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
+}
+
+type accountScopesDataSourceModel struct {
+	ID     types.String   `tfsdk:"id"`
+	Scopes []types.String `tfsdk:"scopes"`
+}
+
+func (d *accountScopesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_scopes"
+}
+
+func (d *accountScopesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = data.client
+	d.rateLimits = data.rateLimits
+}
+
+func (d *accountScopesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Provides the scopes granted to the API key the provider is configured with, for example to check that a CI key has exactly the scopes it needs.
+
+For more detailed information, please see the [SendGrid documentation](https://www.twilio.com/docs/sendgrid/api-reference/api-key-permissions/retrieve-a-list-of-scopes-for-which-this-user-has-access).
+		`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"scopes": schema.ListAttribute{
+				MarkdownDescription: "The scopes granted to the API key, sorted alphabetically.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *accountScopesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var s accountScopesDataSourceModel
+
+	scopes, err := listAccountScopes(ctx, d.rateLimits, sendgridClient{d.client})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading account scopes",
+			fmt.Sprintf("Unable to get the scopes of the API key, got error: %s", sendgridErrorDetail(err)),
+		)
+		return
+	}
+
+	s.ID = types.StringValue("account_scopes")
+	s.Scopes = scopes
+	resp.Diagnostics.Append(resp.State.Set(ctx, &s)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// listAccountScopes returns the scopes granted to the API key in use, sorted so that the result does not
+// depend on the order SendGrid returns them in.
+func listAccountScopes(ctx context.Context, rateLimits *rateLimitBuckets, client scopesLister) ([]types.String, error) {
+	// NOTE: Re-execute after the re-executable time has elapsed when a rate limit occurs
+	res, err := rateLimits.retry(ctx, rateLimitGroupScopes, func() (interface{}, error) {
+		return client.GetScopes(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	names, ok := res.([]string)
+	if !ok {
+		return nil, fmt.Errorf("failed to assert type []string")
+	}
+
+	scopes := []types.String{}
+	for _, name := range slices.Sorted(slices.Values(names)) {
+		scopes = append(scopes, types.StringValue(name))
+	}
+	return scopes, nil
+}
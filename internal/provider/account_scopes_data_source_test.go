@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAccountScopesDataSource(t *testing.T) {
+	resourceName := "data.sendgrid_account_scopes.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `data "sendgrid_account_scopes" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "account_scopes"),
+					resource.TestCheckResourceAttrSet(resourceName, "scopes.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestListAccountScopes(t *testing.T) {
+	cases := []struct {
+		name string
+		body any
+		want []types.String
+	}{
+		{
+			name: "granted scopes",
+			body: map[string]any{"scopes": []string{"mail.send", "alerts.read", "stats.read"}},
+			want: []types.String{types.StringValue("alerts.read"), types.StringValue("mail.send"), types.StringValue("stats.read")},
+		},
+		{name: "no scopes", body: map[string]any{"scopes": []string{}}, want: []types.String{}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rt := newMockTransport()
+			rt.Handle(http.MethodGet, "/v3/scopes", func(_ *http.Request) (int, any) {
+				return http.StatusOK, c.body
+			})
+
+			got, err := listAccountScopes(context.Background(), nil, sendgridClient{newMockClient(rt)})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("got %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestListAccountScopesForbidden(t *testing.T) {
+	rt := newMockTransport()
+	rt.Handle(http.MethodGet, "/v3/scopes", func(_ *http.Request) (int, any) {
+		return http.StatusForbidden, map[string]any{"errors": []map[string]any{{"field": nil, "message": "access forbidden"}}}
+	})
+
+	if _, err := listAccountScopes(context.Background(), nil, sendgridClient{newMockClient(rt)}); err == nil {
+		t.Error("got no error, want the forbidden error")
+	}
+}
@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/i10416/sendgrid"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &addressAllowlistSettingsResource{}
+var _ resource.ResourceWithImportState = &addressAllowlistSettingsResource{}
+
+func newAddressAllowlistSettingsResource() resource.Resource {
+	return &addressAllowlistSettingsResource{}
+}
+
+type addressAllowlistSettingsResource struct {
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
+}
+
+type addressAllowlistSettingsResourceModel struct {
+	Enabled types.Bool     `tfsdk:"enabled"`
+	List    []types.String `tfsdk:"list"`
+}
+
+func (r *addressAllowlistSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_address_allowlist_settings"
+}
+
+func (r *addressAllowlistSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Manages the address allowlist mail setting, which delivers email to the listed addresses and domains even when they are on a suppression list such as bounces or unsubscribes.
+
+Destroying this resource disables the setting and keeps the list as it is.
+
+For more detailed information, please see the [SendGrid documentation](https://www.twilio.com/docs/sendgrid/ui/account-and-settings/mail#address-allowlist).
+		`,
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Indicates if the address allowlist is enabled.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"list": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The email addresses and domains to deliver to regardless of suppressions. Example: [\"user@example.com\", \"example.org\"]. Defaults to an empty list.",
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+		},
+	}
+}
+
+func (r *addressAllowlistSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+	r.rateLimits = data.rateLimits
+}
+
+func (r *addressAllowlistSettingsResource) singleton() singletonResource[addressAllowlistSettingsResourceModel] {
+	client := sendgridClient{r.client}
+	get := func(ctx context.Context) (*addressWhitelist, error) {
+		// NOTE: Re-execute after the re-executable time has elapsed when a rate limit occurs
+		res, err := r.rateLimits.retry(ctx, rateLimitGroupMailSettings, func() (interface{}, error) {
+			return client.GetAddressWhitelist(ctx)
+		})
+		if err != nil {
+			return nil, err
+		}
+		o, ok := res.(*addressWhitelist)
+		if !ok {
+			return nil, fmt.Errorf("failed to assert type *addressWhitelist")
+		}
+		return o, nil
+	}
+	update := func(ctx context.Context, input *inputUpdateAddressWhitelist) (*addressWhitelist, error) {
+		// NOTE: Re-execute after the re-executable time has elapsed when a rate limit occurs
+		res, err := r.rateLimits.retry(ctx, rateLimitGroupMailSettings, func() (interface{}, error) {
+			return client.UpdateAddressWhitelist(ctx, input)
+		})
+		if err != nil {
+			return nil, err
+		}
+		o, ok := res.(*addressWhitelist)
+		if !ok {
+			return nil, fmt.Errorf("failed to assert type *addressWhitelist")
+		}
+		return o, nil
+	}
+
+	return singletonResource[addressAllowlistSettingsResourceModel]{
+		name:     "address allowlist settings",
+		typeName: "sendgrid_address_allowlist_settings",
+		get: func(ctx context.Context) (addressAllowlistSettingsResourceModel, error) {
+			o, err := get(ctx)
+			if err != nil {
+				return addressAllowlistSettingsResourceModel{}, err
+			}
+			return addressAllowlistSettingsModel(o), nil
+		},
+		set: func(ctx context.Context, plan addressAllowlistSettingsResourceModel) (addressAllowlistSettingsResourceModel, error) {
+			current, err := get(ctx)
+			if err != nil {
+				return addressAllowlistSettingsResourceModel{}, err
+			}
+
+			input := &inputUpdateAddressWhitelist{Enabled: plan.Enabled.ValueBool()}
+			desired := []string{}
+			for _, v := range plan.List {
+				desired = append(desired, v.ValueString())
+			}
+			// NOTE: SendGrid replaces the whole list on update, so the list is only sent when an entry
+			//       is added or removed, and the changed entries are logged.
+			toAdd, toRemove := diffSets(desired, current.List)
+			if len(toAdd) > 0 || len(toRemove) > 0 {
+				tflog.Debug(ctx, "Updating the address allowlist", map[string]interface{}{
+					"added":   toAdd,
+					"removed": toRemove,
+				})
+				input.List = &desired
+			}
+			if input.List == nil && input.Enabled == current.Enabled {
+				return addressAllowlistSettingsModel(current), nil
+			}
+
+			o, err := update(ctx, input)
+			if err != nil {
+				return addressAllowlistSettingsResourceModel{}, err
+			}
+			return addressAllowlistSettingsModel(o), nil
+		},
+		disable: func(ctx context.Context) error {
+			_, err := update(ctx, &inputUpdateAddressWhitelist{Enabled: false})
+			return err
+		},
+	}
+}
+
+func (r *addressAllowlistSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.singleton().create(ctx, req, resp)
+}
+
+func (r *addressAllowlistSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.singleton().read(ctx, req, resp)
+}
+
+func (r *addressAllowlistSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.singleton().update(ctx, req, resp)
+}
+
+func (r *addressAllowlistSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.singleton().delete(ctx, req, resp)
+}
+
+func (r *addressAllowlistSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.singleton().importState(ctx, req, resp)
+}
+
+func addressAllowlistSettingsModel(o *addressWhitelist) addressAllowlistSettingsResourceModel {
+	list := []types.String{}
+	for _, v := range o.List {
+		list = append(list, types.StringValue(v))
+	}
+	return addressAllowlistSettingsResourceModel{
+		Enabled: types.BoolValue(o.Enabled),
+		List:    list,
+	}
+}
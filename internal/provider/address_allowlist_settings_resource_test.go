@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAddressAllowlistSettingsResourceWithMockTransport(t *testing.T) {
+	resourceName := "sendgrid_address_allowlist_settings.test"
+	rt, _ := newMockMailSettingTransport("address_whitelist", map[string]any{"enabled": false, "list": []string{}})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactoriesWithTransport(rt),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAddressAllowlistSettingsResourceMockConfig(true, `"user@example.com", "example.org"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "list.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "list.*", "example.org"),
+				),
+			},
+			// Removing an entry
+			{
+				Config: testAddressAllowlistSettingsResourceMockConfig(true, `"example.org"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "list.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "list.*", "example.org"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     singletonImportID,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAddressAllowlistSettingsSet(t *testing.T) {
+	ctx := context.Background()
+	rt, setting := newMockMailSettingTransport("address_whitelist", map[string]any{"enabled": true, "list": []string{"user@example.com"}})
+	r := &addressAllowlistSettingsResource{client: newMockClient(rt)}
+	s := r.singleton()
+
+	patches := func() int {
+		n := 0
+		for _, req := range rt.Requests() {
+			if req.Method == http.MethodPatch {
+				n++
+			}
+		}
+		return n
+	}
+
+	cases := []struct {
+		name        string
+		enabled     bool
+		list        []string
+		wantPatches int
+		wantList    []string
+	}{
+		{name: "add an entry", enabled: true, list: []string{"user@example.com", "example.org"}, wantPatches: 1, wantList: []string{"user@example.com", "example.org"}},
+		{name: "unchanged", enabled: true, list: []string{"example.org", "user@example.com"}, wantPatches: 1, wantList: []string{"user@example.com", "example.org"}},
+		{name: "remove an entry", enabled: true, list: []string{"example.org"}, wantPatches: 2, wantList: []string{"example.org"}},
+		{name: "disable only", enabled: false, list: []string{"example.org"}, wantPatches: 3, wantList: []string{"example.org"}},
+		{name: "remove every entry", enabled: false, list: []string{}, wantPatches: 4, wantList: []string{}},
+	}
+
+	for _, c := range cases {
+		plan := addressAllowlistSettingsResourceModel{Enabled: types.BoolValue(c.enabled), List: []types.String{}}
+		for _, v := range c.list {
+			plan.List = append(plan.List, types.StringValue(v))
+		}
+
+		got, err := s.set(ctx, plan)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", c.name, err)
+		}
+		if got.Enabled.ValueBool() != c.enabled || len(got.List) != len(c.wantList) {
+			t.Errorf("%s: got %+v, want enabled %t and list %v", c.name, got, c.enabled, c.wantList)
+		}
+		if n := patches(); n != c.wantPatches {
+			t.Errorf("%s: got %d PATCH requests in total, want %d", c.name, n, c.wantPatches)
+		}
+		if list := setting.strings("list"); !slices.Equal(list, c.wantList) {
+			t.Errorf("%s: got list %v on SendGrid, want %v", c.name, list, c.wantList)
+		}
+	}
+}
+
+func TestAddressAllowlistSettingsDisableKeepsList(t *testing.T) {
+	rt, setting := newMockMailSettingTransport("address_whitelist", map[string]any{"enabled": true, "list": []string{"user@example.com"}})
+	r := &addressAllowlistSettingsResource{client: newMockClient(rt)}
+
+	if err := r.singleton().disable(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if setting.get("enabled") != false {
+		t.Error("got the address allowlist enabled, want it disabled")
+	}
+	if list := setting.strings("list"); !slices.Equal(list, []string{"user@example.com"}) {
+		t.Errorf("got list %v, want it kept", list)
+	}
+}
+
+// mockMailSetting is the in-memory store behind a mock mail setting endpoint.
+type mockMailSetting struct {
+	mu      sync.Mutex
+	setting map[string]any
+}
+
+func (m *mockMailSetting) get(key string) any {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.setting[key]
+}
+
+func (m *mockMailSetting) strings(key string) []string {
+	var s []string
+	b, _ := json.Marshal(m.get(key))
+	_ = json.Unmarshal(b, &s)
+	return s
+}
+
+// newMockMailSettingTransport returns a mock transport serving the endpoints of the mail setting name,
+// starting from initial, and the store it serves the setting from.
+// Like SendGrid, PATCH only updates the fields it is sent.
+func newMockMailSettingTransport(name string, initial map[string]any) (*mockTransport, *mockMailSetting) {
+	rt := newMockTransport()
+	m := &mockMailSetting{setting: initial}
+
+	rt.Handle(http.MethodGet, "/v3/mail_settings/"+name, func(_ *http.Request) (int, any) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		return http.StatusOK, m.setting
+	})
+	rt.Handle(http.MethodPatch, "/v3/mail_settings/"+name, func(req *http.Request) (int, any) {
+		var in map[string]any
+		if err := json.NewDecoder(req.Body).Decode(&in); err != nil {
+			return http.StatusBadRequest, nil
+		}
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		for k, v := range in {
+			m.setting[k] = v
+		}
+		return http.StatusOK, m.setting
+	})
+
+	return rt, m
+}
+
+func testAddressAllowlistSettingsResourceMockConfig(enabled bool, list string) string {
+	return fmt.Sprintf(`
+provider "sendgrid" {
+	api_key = "SG.test"
+}
+
+resource "sendgrid_address_allowlist_settings" "test" {
+	enabled = %t
+	list    = [%s]
+}
+`, enabled, list)
+}
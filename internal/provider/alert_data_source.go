@@ -25,7 +25,8 @@ func newAlertDataSource() datasource.DataSource {
 }
 
 type alertDataSource struct {
-	client *sendgrid.Client
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
 }
 
 type alertDataSourceModel struct {
@@ -46,16 +47,17 @@ func (d *alertDataSource) Configure(ctx context.Context, req datasource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(*sendgrid.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = data.client
+	d.rateLimits = data.rateLimits
 }
 
 func (d *alertDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
@@ -102,7 +104,7 @@ func (d *alertDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading alert",
-			fmt.Sprintf("Unable to read alert (id: %s), got error: %s", id, err),
+			fmt.Sprintf("Unable to read alert (id: %s), got error: %s", id, sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -111,7 +113,7 @@ func (d *alertDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading alert",
-			fmt.Sprintf("Unable to get alert by id: %s, err: %s", id, err.Error()),
+			fmt.Sprintf("Unable to get alert by id: %s, err: %s", id, sendgridErrorDetail(err)),
 		)
 		return
 	}
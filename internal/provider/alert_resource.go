@@ -28,7 +28,8 @@ func newAlertResource() resource.Resource {
 }
 
 type alertResource struct {
-	client *sendgrid.Client
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
 }
 
 type alertResourceModel struct {
@@ -90,18 +91,19 @@ func (r *alertResource) Configure(ctx context.Context, req resource.ConfigureReq
 		return
 	}
 
-	client, ok := req.ProviderData.(*sendgrid.Client)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+	r.rateLimits = data.rateLimits
 }
 
 func (r *alertResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -111,6 +113,8 @@ func (r *alertResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "create", plan.ID)
+
 	err := validateAlert(&plan)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -121,7 +125,7 @@ func (r *alertResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	// NOTE: Re-execute after the re-executable time has elapsed when a rate limit occurs
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
+	res, err := r.rateLimits.retryNonIdempotent(ctx, rateLimitGroupAlerts, func() (interface{}, error) {
 		return r.client.CreateAlert(ctx, &sendgrid.InputCreateAlert{
 			EmailTo:    plan.EmailTo.ValueString(),
 			Type:       plan.Type.ValueString(),
@@ -132,7 +136,7 @@ func (r *alertResource) Create(ctx context.Context, req resource.CreateRequest,
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Creating alert",
-			fmt.Sprintf("Unable to create alert, got error: %s", err),
+			fmt.Sprintf("Unable to create alert, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -167,6 +171,8 @@ func (r *alertResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "read", state.ID)
+
 	id := state.ID.ValueString()
 	if id == "" {
 		resp.State.RemoveResource(ctx)
@@ -177,7 +183,7 @@ func (r *alertResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading alert",
-			fmt.Sprintf("Unable to read alert (id: %s), got error: %s", id, err),
+			fmt.Sprintf("Unable to read alert (id: %s), got error: %s", id, sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -186,7 +192,7 @@ func (r *alertResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading alert",
-			fmt.Sprintf("Unable to read alert (id: %s), got error: %s", id, err),
+			fmt.Sprintf("Unable to read alert (id: %s), got error: %s", id, sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -211,12 +217,14 @@ func (r *alertResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "update", data.ID)
+
 	id := state.ID.ValueString()
 	idInt64, err := strconv.ParseInt(id, 10, 64)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Updating alert",
-			fmt.Sprintf("Unable to update alert, got error: %s", err),
+			fmt.Sprintf("Unable to update alert, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -229,7 +237,7 @@ func (r *alertResource) Update(ctx context.Context, req resource.UpdateRequest,
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Updating alert",
-			fmt.Sprintf("Unable to update alert, got error: %s", err),
+			fmt.Sprintf("Unable to update alert, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -255,30 +263,34 @@ func (r *alertResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "delete", state.ID)
+
 	id := state.ID.ValueString()
 	idInt64, err := strconv.ParseInt(id, 10, 64)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Deleting alert",
-			fmt.Sprintf("Unable to update alert, got error: %s", err),
+			fmt.Sprintf("Unable to update alert, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
 
 	// NOTE: Re-execute after the re-executable time has elapsed when a rate limit occurs
-	_, err = retryOnRateLimit(ctx, func() (interface{}, error) {
+	_, err = r.rateLimits.retry(ctx, rateLimitGroupAlerts, func() (interface{}, error) {
 		return nil, r.client.DeleteAlert(ctx, idInt64)
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Deleting alert",
-			fmt.Sprintf("Unable to delete alert (id: %s), got error: %s", id, err),
+			fmt.Sprintf("Unable to delete alert (id: %s), got error: %s", id, sendgridErrorDetail(err)),
 		)
 		return
 	}
 }
 
 func (r *alertResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = withAPILogFields(ctx, "import", types.StringValue(req.ID))
+
 	var data alertResourceModel
 
 	id := req.ID
@@ -286,7 +298,7 @@ func (r *alertResource) ImportState(ctx context.Context, req resource.ImportStat
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Importing alert",
-			fmt.Sprintf("Unable to read alert, got error: %s", err),
+			fmt.Sprintf("Unable to read alert, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -297,7 +309,7 @@ func (r *alertResource) ImportState(ctx context.Context, req resource.ImportStat
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Importing alert",
-			fmt.Sprintf("Unable to read alert, got error: %s", err),
+			fmt.Sprintf("Unable to read alert, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -6,6 +6,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/netip"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/i10416/sendgrid"
 )
@@ -26,7 +28,8 @@ func newAllowlistRuleResource() resource.Resource {
 }
 
 type AllowlistRuleResource struct {
-	client *sendgrid.Client
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
 }
 
 type AllowlistRuleResourceModel struct {
@@ -47,8 +50,11 @@ func (r *AllowlistRuleResource) Schema(ctx context.Context, req resource.SchemaR
 				Computed:            true,
 			},
 			"ip": schema.StringAttribute{
-				MarkdownDescription: "The ip to allow access. Example: 1.2.3.4",
+				MarkdownDescription: "The ip or CIDR range to allow access. Example: 1.2.3.4",
 				Required:            true,
+				Validators: []validator.String{
+					stringIPOrCIDR(),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -62,18 +68,19 @@ func (r *AllowlistRuleResource) Configure(ctx context.Context, req resource.Conf
 		return
 	}
 
-	client, ok := req.ProviderData.(*sendgrid.Client)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+	r.rateLimits = data.rateLimits
 }
 
 func (r *AllowlistRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -83,6 +90,8 @@ func (r *AllowlistRuleResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "create", plan.ID)
+
 	err := validateAllowlistRule(&plan)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -93,7 +102,7 @@ func (r *AllowlistRuleResource) Create(ctx context.Context, req resource.CreateR
 	}
 
 	// NOTE: Re-execute after the re-executable time has elapsed when a rate limit occurs
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
+	res, err := r.rateLimits.retryNonIdempotent(ctx, rateLimitGroupAccessSettings, func() (interface{}, error) {
 		return r.client.CreateAllowlistRule(ctx, &sendgrid.InputCreateAllowlistRule{
 			Ips: []sendgrid.InputCreateAllowlistRuleIp{
 				{
@@ -105,12 +114,12 @@ func (r *AllowlistRuleResource) Create(ctx context.Context, req resource.CreateR
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Creating AllowlistRule",
-			fmt.Sprintf("Unable to create AllowlistRule, got error: %s", err),
+			fmt.Sprintf("Unable to create AllowlistRule, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
 
-	o, ok := res.(sendgrid.OutputCreateAllowlistRule)
+	o, ok := res.(*sendgrid.OutputCreateAllowlistRule)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Creating AllowlistRule",
@@ -118,11 +127,30 @@ func (r *AllowlistRuleResource) Create(ctx context.Context, req resource.CreateR
 		)
 		return
 	}
-	one := o.Result[0]
 
+	// NOTE: SendGrid returns every allowlist rule of the account, not only the created one,
+	//       so the created rule is the one matching the submitted ip.
+	ip := plan.Ip.ValueString()
+	idx := -1
+	for i, rule := range o.Result {
+		if sameAllowlistIP(rule.Ip, ip) {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		resp.Diagnostics.AddError(
+			"Creating AllowlistRule",
+			fmt.Sprintf("Unable to find the created AllowlistRule (ip: %s) in the %d rules returned by SendGrid", ip, len(o.Result)),
+		)
+		return
+	}
+	one := o.Result[idx]
+
+	// NOTE: The ip is kept as configured, because SendGrid may return it in CIDR notation, e.g. 1.2.3.4/32 for 1.2.3.4.
 	plan = AllowlistRuleResourceModel{
 		ID: types.Int64Value(one.ID),
-		Ip: types.StringValue(one.Ip),
+		Ip: plan.Ip,
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -138,19 +166,31 @@ func (r *AllowlistRuleResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "read", state.ID)
+
 	id := state.ID.ValueInt64()
 
 	o, err := r.client.GetAllowlistRule(ctx, id)
+	if isNotFoundError(err) {
+		resp.Diagnostics.AddWarning(
+			"Reading AllowlistRule",
+			fmt.Sprintf("AllowlistRule (id: %d) no longer exists in SendGrid and was removed from state, so it will be recreated.", id),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading AllowlistRule",
-			fmt.Sprintf("Unable to read AllowlistRule (id: %d), got error: %e", id, err),
+			fmt.Sprintf("Unable to read AllowlistRule (id: %d), got error: %s", id, sendgridErrorDetail(err)),
 		)
 		return
 	}
 
 	state.ID = types.Int64Value(id)
-	state.Ip = types.StringValue(o.Ip)
+	if !sameAllowlistIP(o.Ip, state.Ip.ValueString()) {
+		state.Ip = types.StringValue(o.Ip)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -159,12 +199,18 @@ func (r *AllowlistRuleResource) Read(ctx context.Context, req resource.ReadReque
 }
 
 func (r *AllowlistRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data, state apiKeyResourceModel
+	var data, state AllowlistRuleResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	ctx = withAPILogFields(ctx, "update", data.ID)
+
+	// NOTE: ip requires replacement and SendGrid has no endpoint to update an allowlist rule,
+	//       so the plan is stored as is while keeping the computed id of the existing rule.
+	data.ID = state.ID
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -178,20 +224,24 @@ func (r *AllowlistRuleResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "delete", state.ID)
+
 	idint64 := state.ID.ValueInt64()
-	_, err := retryOnRateLimit(ctx, func() (interface{}, error) {
+	_, err := r.rateLimits.retry(ctx, rateLimitGroupAccessSettings, func() (interface{}, error) {
 		return nil, r.client.DeleteAllowlistRule(ctx, idint64)
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Deleting Allowlist Rule",
-			fmt.Sprintf("Unable to delete Allowlist Rule (id: %d), got error: %s", idint64, err),
+			fmt.Sprintf("Unable to delete Allowlist Rule (id: %d), got error: %s", idint64, sendgridErrorDetail(err)),
 		)
 		return
 	}
 }
 
 func (r *AllowlistRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = withAPILogFields(ctx, "import", types.StringValue(req.ID))
+
 	var data AllowlistRuleResourceModel
 
 	id := req.ID
@@ -199,7 +249,7 @@ func (r *AllowlistRuleResource) ImportState(ctx context.Context, req resource.Im
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Importing AllowlistRule",
-			fmt.Sprintf("Unable to read AllowlistRule, got error: %s", err),
+			fmt.Sprintf("Unable to read AllowlistRule, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -210,7 +260,7 @@ func (r *AllowlistRuleResource) ImportState(ctx context.Context, req resource.Im
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Importing AllowlistRule",
-			fmt.Sprintf("Unable to read AllowlistRule, got error: %s", err),
+			fmt.Sprintf("Unable to read AllowlistRule, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -226,6 +276,29 @@ func (r *AllowlistRuleResource) ImportState(ctx context.Context, req resource.Im
 	}
 }
 
+// sameAllowlistIP reports whether a and b denote the same allowlisted address or range,
+// treating a single address and its host prefix, e.g. 1.2.3.4 and 1.2.3.4/32, as equal.
+func sameAllowlistIP(a, b string) bool {
+	pa, okA := parseAllowlistIP(a)
+	pb, okB := parseAllowlistIP(b)
+	if !okA || !okB {
+		return a == b
+	}
+	return pa == pb
+}
+
+// parseAllowlistIP parses an address or a CIDR range into a masked prefix.
+func parseAllowlistIP(s string) (netip.Prefix, bool) {
+	if p, err := netip.ParsePrefix(s); err == nil {
+		return p.Masked(), true
+	}
+	a, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Prefix{}, false
+	}
+	return netip.PrefixFrom(a, a.BitLen()), true
+}
+
 func validateAllowlistRule(_ *AllowlistRuleResourceModel) error {
 	return nil
 }
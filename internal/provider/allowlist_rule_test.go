@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestAllowlistRuleResourceUpdate(t *testing.T) {
+	ctx := context.Background()
+	r := &AllowlistRuleResource{}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	s := schemaResp.Schema
+
+	value := func(id any, ip string) tftypes.Value {
+		return tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"id": tftypes.NewValue(tftypes.Number, id),
+			"ip": tftypes.NewValue(tftypes.String, ip),
+		})
+	}
+
+	resp := &resource.UpdateResponse{
+		State: tfsdk.State{Schema: s, Raw: value(tftypes.UnknownValue, "1.2.3.4")},
+	}
+	r.Update(ctx, resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: s, Raw: value(tftypes.UnknownValue, "1.2.3.4")},
+		State: tfsdk.State{Schema: s, Raw: value(42, "1.2.3.4")},
+	}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var got AllowlistRuleResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if got.ID.ValueInt64() != 42 || got.Ip.ValueString() != "1.2.3.4" {
+		t.Errorf("got %+v, want id 42 and ip 1.2.3.4", got)
+	}
+}
+
+func TestAllowlistRuleResourceReadNotFound(t *testing.T) {
+	ctx := context.Background()
+
+	// SendGrid answers 404 Not Found with either body, or none at all.
+	cases := map[string]any{
+		"errors body": map[string]any{"errors": []map[string]any{{"field": nil, "message": "resource not found"}}},
+		"error body":  map[string]any{"error": "not found"},
+		"no body":     nil,
+	}
+
+	for name, body := range cases {
+		t.Run(name, func(t *testing.T) {
+			rt := newMockTransport()
+			rt.Handle(http.MethodGet, "/v3/access_settings/whitelist/42", func(_ *http.Request) (int, any) {
+				return http.StatusNotFound, body
+			})
+			r := &AllowlistRuleResource{client: newMockClient(rt)}
+
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			s := schemaResp.Schema
+
+			state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"id": tftypes.NewValue(tftypes.Number, 42),
+				"ip": tftypes.NewValue(tftypes.String, "1.2.3.4"),
+			})}
+			resp := &resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if resp.Diagnostics.WarningsCount() != 1 {
+				t.Errorf("got %v, want a single warning", resp.Diagnostics)
+			}
+			if !resp.State.Raw.IsNull() {
+				t.Errorf("got state %v, want it to be removed", resp.State.Raw)
+			}
+		})
+	}
+}
+
+func TestAllowlistRuleResourceReadError(t *testing.T) {
+	ctx := context.Background()
+	rt := newMockTransport()
+	rt.Handle(http.MethodGet, "/v3/access_settings/whitelist/42", func(_ *http.Request) (int, any) {
+		return http.StatusForbidden, map[string]any{"errors": []map[string]any{{"field": nil, "message": "access forbidden"}}}
+	})
+	r := &AllowlistRuleResource{client: newMockClient(rt)}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	s := schemaResp.Schema
+
+	state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.Number, 42),
+		"ip": tftypes.NewValue(tftypes.String, "1.2.3.4"),
+	})}
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+	if resp.Diagnostics.ErrorsCount() != 1 {
+		t.Fatalf("got %v, want a single error", resp.Diagnostics)
+	}
+	detail := resp.Diagnostics.Errors()[0].Detail()
+	if !strings.Contains(detail, "access forbidden") || strings.Contains(detail, "%!") {
+		t.Errorf("got detail %q, want it to contain the error message from SendGrid", detail)
+	}
+}
+
+func TestAllowlistRuleResourceCreateMatchesIP(t *testing.T) {
+	ctx := context.Background()
+
+	rt := newMockTransport()
+	// SendGrid returns every rule of the account, in no particular order.
+	rt.Handle(http.MethodPost, "/v3/access_settings/whitelist", func(_ *http.Request) (int, any) {
+		return http.StatusCreated, map[string]any{
+			"result": []map[string]any{
+				{"id": 1, "ip": "192.168.0.1"},
+				{"id": 3, "ip": "10.0.0.0/8"},
+				{"id": 2, "ip": "1.2.3.4/32"},
+			},
+		}
+	})
+	r := &AllowlistRuleResource{client: newMockClient(rt)}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	s := schemaResp.Schema
+
+	create := func(ip string) *resource.CreateResponse {
+		plan := tfsdk.Plan{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"id": tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+			"ip": tftypes.NewValue(tftypes.String, ip),
+		})}
+		resp := &resource.CreateResponse{State: tfsdk.State{Schema: s, Raw: plan.Raw}}
+		r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+		return resp
+	}
+
+	resp := create("1.2.3.4")
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	var got AllowlistRuleResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	if got.ID.ValueInt64() != 2 || got.Ip.ValueString() != "1.2.3.4" {
+		t.Errorf("got %+v, want id 2 and ip 1.2.3.4", got)
+	}
+
+	// No returned rule matches the submitted ip.
+	if resp := create("5.6.7.8"); !resp.Diagnostics.HasError() {
+		t.Error("expected an error when no returned rule matches the ip")
+	}
+}
+
+func TestSameAllowlistIP(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want bool
+	}{
+		{a: "1.2.3.4", b: "1.2.3.4", want: true},
+		{a: "1.2.3.4/32", b: "1.2.3.4", want: true},
+		{a: "10.0.0.0/8", b: "10.0.0.0/8", want: true},
+		{a: "2001:db8::1/128", b: "2001:db8::1", want: true},
+		{a: "1.2.3.4/24", b: "1.2.3.0/24", want: true},
+		{a: "1.2.3.4", b: "1.2.3.5"},
+		{a: "1.2.3.0/24", b: "1.2.3.0"},
+		{a: "not-an-ip", b: "not-an-ip", want: true},
+		{a: "not-an-ip", b: "1.2.3.4"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.a+" "+c.b, func(t *testing.T) {
+			if got := sameAllowlistIP(c.a, c.b); got != c.want {
+				t.Errorf("got %t, want %t", got, c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/i10416/sendgrid"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &allowlistRulesDataSource{}
+	_ datasource.DataSourceWithConfigure = &allowlistRulesDataSource{}
+)
+
+// allowlistRuleLister lists every rule in the IP allowlist.
+type allowlistRuleLister interface {
+	GetAllowlistRules(ctx context.Context) ([]sendgrid.AllowlistRule, error)
+}
+
+func newAllowlistRulesDataSource() datasource.DataSource {
+	return &allowlistRulesDataSource{}
+}
+
+type allowlistRulesDataSource struct {
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
+}
+
+type allowlistRulesDataSourceModel struct {
+	ID    types.String                        `tfsdk:"id"`
+	Rules []allowlistRulesDataSourceItemModel `tfsdk:"rules"`
+}
+
+type allowlistRulesDataSourceItemModel struct {
+	ID types.Int64  `tfsdk:"id"`
+	Ip types.String `tfsdk:"ip"`
+}
+
+func (d *allowlistRulesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_allowlist_rules"
+}
+
+func (d *allowlistRulesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = data.client
+	d.rateLimits = data.rateLimits
+}
+
+func (d *allowlistRulesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Provides every rule in the IP access management allowlist.
+
+This is useful for reconciling the allowlist of an existing account before importing individual ` + "`sendgrid_allowlist_rule`" + ` resources.
+		`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"rules": schema.ListNestedAttribute{
+				MarkdownDescription: "All allowlist rules. Empty if the allowlist has no entries.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of AllowlistRule",
+							Computed:            true,
+						},
+						"ip": schema.StringAttribute{
+							MarkdownDescription: "The allowed ip or CIDR range",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *allowlistRulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var s allowlistRulesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &s)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := listAllowlistRules(ctx, d.rateLimits, sendgridClient{d.client})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading allowlist rules",
+			fmt.Sprintf("Unable to get allowlist rules, got error: %s", sendgridErrorDetail(err)),
+		)
+		return
+	}
+
+	s = allowlistRulesDataSourceModel{
+		ID:    types.StringValue("allowlist_rules"),
+		Rules: rules,
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &s)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// listAllowlistRules returns every allowlist rule, or an empty list when the allowlist has no entries.
+func listAllowlistRules(ctx context.Context, rateLimits *rateLimitBuckets, client allowlistRuleLister) ([]allowlistRulesDataSourceItemModel, error) {
+	// NOTE: Re-execute after the re-executable time has elapsed when a rate limit occurs
+	res, err := rateLimits.retry(ctx, rateLimitGroupAccessSettings, func() (interface{}, error) {
+		return client.GetAllowlistRules(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rules, ok := res.([]sendgrid.AllowlistRule)
+	if !ok {
+		return nil, fmt.Errorf("failed to assert type []sendgrid.AllowlistRule")
+	}
+
+	items := []allowlistRulesDataSourceItemModel{}
+	for _, r := range rules {
+		items = append(items, allowlistRulesDataSourceItemModel{
+			ID: types.Int64Value(r.ID),
+			Ip: types.StringValue(r.Ip),
+		})
+	}
+	return items, nil
+}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/i10416/sendgrid"
+)
+
+type mockAllowlistRuleLister struct {
+	rules []sendgrid.AllowlistRule
+	// rateLimited is the number of calls answered with a rate limit error before rules are returned.
+	rateLimited int
+	calls       int
+}
+
+func (m *mockAllowlistRuleLister) GetAllowlistRules(_ context.Context) ([]sendgrid.AllowlistRule, error) {
+	m.calls++
+	if m.calls <= m.rateLimited {
+		return nil, &sendgrid.RateLimitedError{RetryAfter: time.Millisecond}
+	}
+	return m.rules, nil
+}
+
+func TestAccAllowlistRulesDataSource(t *testing.T) {
+	resourceName := "data.sendgrid_allowlist_rules.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `data "sendgrid_allowlist_rules" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "allowlist_rules"),
+					resource.TestCheckResourceAttrSet(resourceName, "rules.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestListAllowlistRules(t *testing.T) {
+	cases := []struct {
+		name   string
+		client *mockAllowlistRuleLister
+		want   []allowlistRulesDataSourceItemModel
+	}{
+		{
+			name:   "empty",
+			client: &mockAllowlistRuleLister{},
+			want:   []allowlistRulesDataSourceItemModel{},
+		},
+		{
+			name: "multiple",
+			client: &mockAllowlistRuleLister{
+				rules: []sendgrid.AllowlistRule{
+					{ID: 1, Ip: "192.0.2.1"},
+					{ID: 2, Ip: "198.51.100.0/24"},
+				},
+			},
+			want: []allowlistRulesDataSourceItemModel{
+				{ID: types.Int64Value(1), Ip: types.StringValue("192.0.2.1")},
+				{ID: types.Int64Value(2), Ip: types.StringValue("198.51.100.0/24")},
+			},
+		},
+		{
+			name: "rate limited",
+			client: &mockAllowlistRuleLister{
+				rules:       []sendgrid.AllowlistRule{{ID: 1, Ip: "192.0.2.1"}},
+				rateLimited: 1,
+			},
+			want: []allowlistRulesDataSourceItemModel{
+				{ID: types.Int64Value(1), Ip: types.StringValue("192.0.2.1")},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := listAllowlistRules(context.Background(), nil, c.client)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("got %+v, want %+v", got, c.want)
+			}
+			if want := c.client.rateLimited + 1; c.client.calls != want {
+				t.Errorf("got %d calls, want %d", c.client.calls, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,406 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/i10416/sendgrid"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AllowlistRulesResource{}
+var _ resource.ResourceWithImportState = &AllowlistRulesResource{}
+
+func newAllowlistRulesResource() resource.Resource {
+	return &AllowlistRulesResource{}
+}
+
+type AllowlistRulesResource struct {
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
+}
+
+type AllowlistRulesResourceModel struct {
+	ID      types.String   `tfsdk:"id"`
+	Ips     []types.String `tfsdk:"ips"`
+	RuleIDs types.Map      `tfsdk:"rule_ids"`
+}
+
+func (r *AllowlistRulesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_allowlist_rules"
+}
+
+func (r *AllowlistRulesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Manages a set of IP access management allowlist rules as a single batch.
+
+Changing ` + "`ips`" + ` only creates the rules for the added ips and deletes the rules of the removed ips, so the other rules keep their IDs.
+Do not manage the same ip with both this resource and ` + "`sendgrid_allowlist_rule`" + `.
+		`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ips": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The ips or CIDR ranges to allow access. Example: [\"1.2.3.4\", \"5.6.7.0/24\"]",
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringIPOrCIDR()),
+				},
+			},
+			"rule_ids": schema.MapAttribute{
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "The ID of the AllowlistRule of each ip, keyed by ip",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *AllowlistRulesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+	r.rateLimits = data.rateLimits
+}
+
+func (r *AllowlistRulesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AllowlistRulesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAPILogFields(ctx, "create", plan.ID)
+
+	ips := []string{}
+	for _, ip := range plan.Ips {
+		ips = append(ips, ip.ValueString())
+	}
+
+	ruleIDs, err := r.createAllowlistRules(ctx, ips)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Creating AllowlistRules",
+			fmt.Sprintf("Unable to create AllowlistRules, got error: %s", sendgridErrorDetail(err)),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue("allowlist_rules")
+	resp.Diagnostics.Append(plan.setRuleIDs(ctx, ruleIDs)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *AllowlistRulesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AllowlistRulesResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAPILogFields(ctx, "read", state.ID)
+
+	ruleIDs := map[string]int64{}
+	resp.Diagnostics.Append(state.RuleIDs.ElementsAs(ctx, &ruleIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.rateLimits.retry(ctx, rateLimitGroupAccessSettings, func() (interface{}, error) {
+		return sendgridClient{r.client}.GetAllowlistRules(ctx)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading AllowlistRules",
+			fmt.Sprintf("Unable to read AllowlistRules, got error: %s", sendgridErrorDetail(err)),
+		)
+		return
+	}
+
+	rules, ok := res.([]sendgrid.AllowlistRule)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Reading AllowlistRules",
+			"Failed to assert type []sendgrid.AllowlistRule",
+		)
+		return
+	}
+
+	// NOTE: Rules deleted outside of Terraform are dropped, so the next plan creates them again.
+	remote := map[int64]string{}
+	for _, rule := range rules {
+		remote[rule.ID] = rule.Ip
+	}
+	current := map[string]int64{}
+	for ip, id := range ruleIDs {
+		remoteIP, ok := remote[id]
+		if !ok {
+			continue
+		}
+		if !sameAllowlistIP(remoteIP, ip) {
+			ip = remoteIP
+		}
+		current[ip] = id
+	}
+
+	state.Ips = []types.String{}
+	for _, ip := range slices.Sorted(maps.Keys(current)) {
+		state.Ips = append(state.Ips, types.StringValue(ip))
+	}
+	resp.Diagnostics.Append(state.setRuleIDs(ctx, current)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *AllowlistRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state AllowlistRulesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAPILogFields(ctx, "update", state.ID)
+
+	ruleIDs := map[string]int64{}
+	resp.Diagnostics.Append(state.RuleIDs.ElementsAs(ctx, &ruleIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired := []string{}
+	for _, ip := range data.Ips {
+		desired = append(desired, ip.ValueString())
+	}
+	toCreate, toDelete := allowlistRulesDelta(desired, ruleIDs)
+
+	for _, ip := range toDelete {
+		id := ruleIDs[ip]
+		_, err := r.rateLimits.retry(ctx, rateLimitGroupAccessSettings, func() (interface{}, error) {
+			return nil, r.client.DeleteAllowlistRule(ctx, id)
+		})
+		if err != nil && !isNotFoundError(err) {
+			resp.Diagnostics.AddError(
+				"Updating AllowlistRules",
+				fmt.Sprintf("Unable to delete AllowlistRule (id: %d, ip: %s), got error: %s", id, ip, sendgridErrorDetail(err)),
+			)
+			return
+		}
+		delete(ruleIDs, ip)
+	}
+
+	if len(toCreate) > 0 {
+		created, err := r.createAllowlistRules(ctx, toCreate)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Updating AllowlistRules",
+				fmt.Sprintf("Unable to create AllowlistRules, got error: %s", sendgridErrorDetail(err)),
+			)
+			return
+		}
+		for ip, id := range created {
+			ruleIDs[ip] = id
+		}
+	}
+
+	data.ID = state.ID
+	resp.Diagnostics.Append(data.setRuleIDs(ctx, ruleIDs)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *AllowlistRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AllowlistRulesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAPILogFields(ctx, "delete", state.ID)
+
+	ruleIDs := map[string]int64{}
+	resp.Diagnostics.Append(state.RuleIDs.ElementsAs(ctx, &ruleIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, ip := range slices.Sorted(maps.Keys(ruleIDs)) {
+		id := ruleIDs[ip]
+		_, err := r.rateLimits.retry(ctx, rateLimitGroupAccessSettings, func() (interface{}, error) {
+			return nil, r.client.DeleteAllowlistRule(ctx, id)
+		})
+		if err != nil && !isNotFoundError(err) {
+			resp.Diagnostics.AddError(
+				"Deleting AllowlistRules",
+				fmt.Sprintf("Unable to delete AllowlistRule (id: %d, ip: %s), got error: %s", id, ip, sendgridErrorDetail(err)),
+			)
+			return
+		}
+	}
+}
+
+func (r *AllowlistRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = withAPILogFields(ctx, "import", types.StringValue(req.ID))
+
+	// id = rule_id,rule_id,...
+	ids := []int64{}
+	for _, s := range strings.Split(req.ID, ",") {
+		id, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Importing AllowlistRules",
+				fmt.Sprintf("Unable to import AllowlistRules, id must be comma-separated AllowlistRule IDs such as 1,2,3, got: %q", req.ID),
+			)
+			return
+		}
+		ids = append(ids, id)
+	}
+
+	res, err := r.rateLimits.retry(ctx, rateLimitGroupAccessSettings, func() (interface{}, error) {
+		return sendgridClient{r.client}.GetAllowlistRules(ctx)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Importing AllowlistRules",
+			fmt.Sprintf("Unable to read AllowlistRules, got error: %s", sendgridErrorDetail(err)),
+		)
+		return
+	}
+
+	rules, ok := res.([]sendgrid.AllowlistRule)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Importing AllowlistRules",
+			"Failed to assert type []sendgrid.AllowlistRule",
+		)
+		return
+	}
+
+	remote := map[int64]string{}
+	for _, rule := range rules {
+		remote[rule.ID] = rule.Ip
+	}
+	ruleIDs := map[string]int64{}
+	for _, id := range ids {
+		ip, ok := remote[id]
+		if !ok {
+			resp.Diagnostics.AddError(
+				"Importing AllowlistRules",
+				fmt.Sprintf("Unable to find AllowlistRule (id: %d)", id),
+			)
+			return
+		}
+		ruleIDs[ip] = id
+	}
+
+	data := AllowlistRulesResourceModel{
+		ID:  types.StringValue("allowlist_rules"),
+		Ips: []types.String{},
+	}
+	for _, ip := range slices.Sorted(maps.Keys(ruleIDs)) {
+		data.Ips = append(data.Ips, types.StringValue(ip))
+	}
+	resp.Diagnostics.Append(data.setRuleIDs(ctx, ruleIDs)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// createAllowlistRules creates the rules of ips in a single request and returns their IDs keyed by ip.
+func (r *AllowlistRulesResource) createAllowlistRules(ctx context.Context, ips []string) (map[string]int64, error) {
+	input := &sendgrid.InputCreateAllowlistRule{}
+	for _, ip := range ips {
+		input.Ips = append(input.Ips, sendgrid.InputCreateAllowlistRuleIp{Ip: ip})
+	}
+
+	// NOTE: Re-execute after the re-executable time has elapsed when a rate limit occurs
+	res, err := r.rateLimits.retryNonIdempotent(ctx, rateLimitGroupAccessSettings, func() (interface{}, error) {
+		return r.client.CreateAllowlistRule(ctx, input)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	o, ok := res.(*sendgrid.OutputCreateAllowlistRule)
+	if !ok {
+		return nil, fmt.Errorf("failed to assert type *sendgrid.OutputCreateAllowlistRule")
+	}
+
+	return matchAllowlistRules(ips, o.Result)
+}
+
+// matchAllowlistRules finds the rule of each ip among rules, which SendGrid returns for the whole account.
+// The ips are kept as configured, because SendGrid may return them in CIDR notation.
+func matchAllowlistRules(ips []string, rules []sendgrid.AllowlistRule) (map[string]int64, error) {
+	ruleIDs := map[string]int64{}
+	for _, ip := range ips {
+		found := false
+		for _, rule := range rules {
+			if sameAllowlistIP(rule.Ip, ip) {
+				ruleIDs[ip] = rule.ID
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unable to find the created AllowlistRule (ip: %s) in the %d rules returned by SendGrid", ip, len(rules))
+		}
+	}
+	return ruleIDs, nil
+}
+
+// allowlistRulesDelta returns the ips whose rules must be created and the ips whose rules must be deleted
+// to change the rules in ruleIDs into the desired ips.
+func allowlistRulesDelta(desired []string, ruleIDs map[string]int64) (toCreate, toDelete []string) {
+	return diffSets(desired, slices.Sorted(maps.Keys(ruleIDs)))
+}
+
+func (m *AllowlistRulesResourceModel) setRuleIDs(ctx context.Context, ruleIDs map[string]int64) diag.Diagnostics {
+	v, diags := types.MapValueFrom(ctx, types.Int64Type, ruleIDs)
+	m.RuleIDs = v
+	return diags
+}
@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/i10416/sendgrid"
+)
+
+func TestAllowlistRulesDelta(t *testing.T) {
+	cases := []struct {
+		name         string
+		desired      []string
+		ruleIDs      map[string]int64
+		wantToCreate []string
+		wantToDelete []string
+	}{
+		{
+			name:         "create all",
+			desired:      []string{"192.0.2.1", "198.51.100.0/24"},
+			ruleIDs:      map[string]int64{},
+			wantToCreate: []string{"192.0.2.1", "198.51.100.0/24"},
+		},
+		{
+			name:    "unchanged",
+			desired: []string{"198.51.100.0/24", "192.0.2.1"},
+			ruleIDs: map[string]int64{"192.0.2.1": 1, "198.51.100.0/24": 2},
+		},
+		{
+			name:         "add and remove",
+			desired:      []string{"192.0.2.1", "203.0.113.7"},
+			ruleIDs:      map[string]int64{"192.0.2.1": 1, "198.51.100.0/24": 2},
+			wantToCreate: []string{"203.0.113.7"},
+			wantToDelete: []string{"198.51.100.0/24"},
+		},
+		{
+			name:         "replace all",
+			desired:      []string{"203.0.113.7"},
+			ruleIDs:      map[string]int64{"198.51.100.0/24": 2, "192.0.2.1": 1},
+			wantToCreate: []string{"203.0.113.7"},
+			wantToDelete: []string{"192.0.2.1", "198.51.100.0/24"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			toCreate, toDelete := allowlistRulesDelta(c.desired, c.ruleIDs)
+			if !slices.Equal(toCreate, c.wantToCreate) {
+				t.Errorf("got toCreate %v, want %v", toCreate, c.wantToCreate)
+			}
+			if !slices.Equal(toDelete, c.wantToDelete) {
+				t.Errorf("got toDelete %v, want %v", toDelete, c.wantToDelete)
+			}
+		})
+	}
+}
+
+func TestMatchAllowlistRules(t *testing.T) {
+	// NOTE: SendGrid returns every rule of the account, and single addresses in CIDR notation.
+	rules := []sendgrid.AllowlistRule{
+		{ID: 1, Ip: "203.0.113.7/32"},
+		{ID: 2, Ip: "192.0.2.1/32"},
+		{ID: 3, Ip: "198.51.100.0/24"},
+	}
+
+	got, err := matchAllowlistRules([]string{"192.0.2.1", "198.51.100.0/24"}, rules)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	want := map[string]int64{"192.0.2.1": 2, "198.51.100.0/24": 3}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if _, err := matchAllowlistRules([]string{"192.0.2.2"}, rules); err == nil {
+		t.Error("got no error for an ip without a rule, want one")
+	}
+}
+
+// testAllowlistRulesState returns the state of an AllowlistRulesResource managing the rules in ruleIDs.
+func testAllowlistRulesState(t *testing.T, r *AllowlistRulesResource, ruleIDs map[string]int64) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	s := schemaResp.Schema
+
+	data := AllowlistRulesResourceModel{ID: types.StringValue("allowlist_rules"), Ips: []types.String{}}
+	for ip := range ruleIDs {
+		data.Ips = append(data.Ips, types.StringValue(ip))
+	}
+	diags := data.setRuleIDs(ctx, ruleIDs)
+
+	state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	diags.Append(state.Set(ctx, &data)...)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	return state
+}
+
+func TestAllowlistRulesResourceUpdate(t *testing.T) {
+	ctx := context.Background()
+
+	rt := newMockTransport()
+	deleted := []string{}
+	for _, id := range []string{"1", "2"} {
+		rt.Handle(http.MethodDelete, "/v3/access_settings/whitelist/"+id, func(_ *http.Request) (int, any) {
+			deleted = append(deleted, id)
+			return http.StatusNoContent, nil
+		})
+	}
+	var created []string
+	rt.Handle(http.MethodPost, "/v3/access_settings/whitelist", func(req *http.Request) (int, any) {
+		b, _ := io.ReadAll(req.Body)
+		input := sendgrid.InputCreateAllowlistRule{}
+		if err := json.Unmarshal(b, &input); err != nil {
+			t.Errorf("unexpected request body %s: %s", b, err)
+		}
+		for _, ip := range input.Ips {
+			created = append(created, ip.Ip)
+		}
+		return http.StatusCreated, map[string]any{
+			"result": []map[string]any{
+				{"id": 2, "ip": "198.51.100.0/24"},
+				{"id": 3, "ip": "203.0.113.7/32"},
+			},
+		}
+	})
+	r := &AllowlistRulesResource{client: newMockClient(rt)}
+
+	state := testAllowlistRulesState(t, r, map[string]int64{"192.0.2.1": 1, "198.51.100.0/24": 2})
+	plan := testAllowlistRulesState(t, r, map[string]int64{"198.51.100.0/24": 2})
+	if diags := plan.SetAttribute(ctx, path.Root("ips"), []string{"198.51.100.0/24", "203.0.113.7"}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	resp := &resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{Plan: tfsdk.Plan(plan), State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	// NOTE: Only the removed ip is deleted and only the added ip is created, so 198.51.100.0/24 keeps its rule.
+	if !reflect.DeepEqual(deleted, []string{"1"}) {
+		t.Errorf("deleted rules %v, want [1]", deleted)
+	}
+	if !reflect.DeepEqual(created, []string{"203.0.113.7"}) {
+		t.Errorf("created rules for %v, want [203.0.113.7]", created)
+	}
+
+	var got AllowlistRulesResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	ruleIDs := map[string]int64{}
+	resp.Diagnostics.Append(got.RuleIDs.ElementsAs(ctx, &ruleIDs, false)...)
+	want := map[string]int64{"198.51.100.0/24": 2, "203.0.113.7": 3}
+	if !reflect.DeepEqual(ruleIDs, want) {
+		t.Errorf("got rule_ids %v, want %v", ruleIDs, want)
+	}
+}
+
+func TestAllowlistRulesResourceImportState(t *testing.T) {
+	ctx := context.Background()
+
+	rt := newMockTransport()
+	rt.Handle(http.MethodGet, "/v3/access_settings/whitelist", func(_ *http.Request) (int, any) {
+		return http.StatusOK, map[string]any{
+			"result": []map[string]any{
+				{"id": 1, "ip": "192.0.2.1/32"},
+				{"id": 2, "ip": "198.51.100.0/24"},
+				{"id": 3, "ip": "203.0.113.7/32"},
+			},
+		}
+	})
+	r := &AllowlistRulesResource{client: newMockClient(rt)}
+
+	importState := func(id string) *resource.ImportStateResponse {
+		resp := &resource.ImportStateResponse{State: testAllowlistRulesState(t, r, map[string]int64{})}
+		r.ImportState(ctx, resource.ImportStateRequest{ID: id}, resp)
+		return resp
+	}
+
+	resp := importState("1, 3")
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	var got AllowlistRulesResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	ruleIDs := map[string]int64{}
+	resp.Diagnostics.Append(got.RuleIDs.ElementsAs(ctx, &ruleIDs, false)...)
+	want := map[string]int64{"192.0.2.1/32": 1, "203.0.113.7/32": 3}
+	if !reflect.DeepEqual(ruleIDs, want) {
+		t.Errorf("got rule_ids %v, want %v", ruleIDs, want)
+	}
+
+	for _, id := range []string{"", "1,abc", "1,4"} {
+		if resp := importState(id); !resp.Diagnostics.HasError() {
+			t.Errorf("import of %q got no error, want one", id)
+		}
+	}
+}
@@ -24,7 +24,8 @@ func newAPIKeyDataSource() datasource.DataSource {
 }
 
 type apiKeyDataSource struct {
-	client *sendgrid.Client
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
 }
 
 type apiKeyDataSourceModel struct {
@@ -43,16 +44,17 @@ func (d *apiKeyDataSource) Configure(ctx context.Context, req datasource.Configu
 		return
 	}
 
-	client, ok := req.ProviderData.(*sendgrid.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = data.client
+	d.rateLimits = data.rateLimits
 }
 
 func (d *apiKeyDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
@@ -97,7 +99,7 @@ func (d *apiKeyDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading api key",
-			fmt.Sprintf("Unable to get api key by id: %s, err: %s", id, err.Error()),
+			fmt.Sprintf("Unable to get api key by id: %s, err: %s", id, sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -6,7 +6,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"reflect"
 	"slices"
 	"strings"
 
@@ -27,7 +26,8 @@ func newAPIKeyResource() resource.Resource {
 }
 
 type apiKeyResource struct {
-	client *sendgrid.Client
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
 }
 
 type apiKeyResourceModel struct {
@@ -43,6 +43,15 @@ var defaultScopes = []string{
 	"2fa_required",
 }
 
+// changedAPIKeyScopes returns the planned scopes to send to SendGrid, or nil when they are unchanged.
+// Scopes are compared as sets, so a different order is not a change.
+func changedAPIKeyScopes(ctx context.Context, plan, state types.Set) []string {
+	if plan.Equal(state) {
+		return nil
+	}
+	return excludeDefaultScopes(flex.ExpandFrameworkStringSet(ctx, plan))
+}
+
 func excludeDefaultScopes(scopes []string) []string {
 	// Exclude default scopes from the provided scopes
 	filteredScopes := make([]string, 0, len(scopes))
@@ -83,6 +92,8 @@ For more detailed information, please see the [SendGrid documentation](https://d
 The following Scopes are set automatically by SendGrid, so they cannot be set manually:
 
 - ` + strings.Join(defaultScopes, "\n- ") + `
+
+Scopes are a set, so their order does not matter and SendGrid returning them in a different order does not produce a diff.
 `,
 				Optional: true,
 			},
@@ -100,18 +111,19 @@ func (r *apiKeyResource) Configure(ctx context.Context, req resource.ConfigureRe
 		return
 	}
 
-	client, ok := req.ProviderData.(*sendgrid.Client)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+	r.rateLimits = data.rateLimits
 }
 
 func (r *apiKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -121,6 +133,8 @@ func (r *apiKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "create", plan.ID)
+
 	scopes := flex.ExpandFrameworkStringSet(ctx, plan.Scopes)
 
 	for _, s := range scopes {
@@ -138,7 +152,7 @@ func (r *apiKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	// NOTE: Re-execute after the re-executable time has elapsed when a rate limit occurs
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
+	res, err := r.rateLimits.retryNonIdempotent(ctx, rateLimitGroupAPIKeys, func() (interface{}, error) {
 		return r.client.CreateAPIKey(ctx, &sendgrid.InputCreateAPIKey{
 			Name:   plan.Name.ValueString(),
 			Scopes: scopes,
@@ -147,7 +161,7 @@ func (r *apiKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Creating api key",
-			fmt.Sprintf("Unable to create api key, got error: %s", err),
+			fmt.Sprintf("Unable to create api key, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -187,6 +201,8 @@ func (r *apiKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "read", state.ID)
+
 	id := state.ID.ValueString()
 	if id == "" {
 		resp.State.RemoveResource(ctx)
@@ -197,7 +213,7 @@ func (r *apiKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading api key",
-			fmt.Sprintf("Unable to read api key (id: %s), got error: %s", id, err),
+			fmt.Sprintf("Unable to read api key (id: %s), got error: %s", id, sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -225,6 +241,8 @@ func (r *apiKeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "update", data.ID)
+
 	id := state.ID.ValueString()
 
 	dataScopes := flex.ExpandFrameworkStringSet(ctx, data.Scopes)
@@ -242,12 +260,7 @@ func (r *apiKeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 	}
 
-	var scopes []string
-	if !reflect.DeepEqual(data.Scopes, state.Scopes) {
-		scopes = flex.ExpandFrameworkStringSet(ctx, data.Scopes)
-	}
-
-	scopes = excludeDefaultScopes(scopes)
+	scopes := changedAPIKeyScopes(ctx, data.Scopes, state.Scopes)
 
 	data.ID = types.StringValue(id)
 	data.APIKey = state.APIKey
@@ -261,7 +274,7 @@ func (r *apiKeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Updating api key",
-				fmt.Sprintf("Unable to update api key's permissions and name, got error: %s", err),
+				fmt.Sprintf("Unable to update api key's permissions and name, got error: %s", sendgridErrorDetail(err)),
 			)
 			return
 		}
@@ -277,7 +290,7 @@ func (r *apiKeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Updating api key",
-				fmt.Sprintf("Unable to update api key's name, got error: %s", err),
+				fmt.Sprintf("Unable to update api key's name, got error: %s", sendgridErrorDetail(err)),
 			)
 			return
 		}
@@ -298,22 +311,26 @@ func (r *apiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "delete", state.ID)
+
 	id := state.ID.ValueString()
 
 	// NOTE: Re-execute after the re-executable time has elapsed when a rate limit occurs
-	_, err := retryOnRateLimit(ctx, func() (interface{}, error) {
+	_, err := r.rateLimits.retry(ctx, rateLimitGroupAPIKeys, func() (interface{}, error) {
 		return nil, r.client.DeleteAPIKey(ctx, id)
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Deleting api key",
-			fmt.Sprintf("Unable to delete api key (id: %s), got error: %s", id, err),
+			fmt.Sprintf("Unable to delete api key (id: %s), got error: %s", id, sendgridErrorDetail(err)),
 		)
 		return
 	}
 }
 
 func (r *apiKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = withAPILogFields(ctx, "import", types.StringValue(req.ID))
+
 	var data apiKeyResourceModel
 
 	id := req.ID
@@ -324,7 +341,7 @@ func (r *apiKeyResource) ImportState(ctx context.Context, req resource.ImportSta
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Importing api key",
-			fmt.Sprintf("Unable to read api key, got error: %s", err),
+			fmt.Sprintf("Unable to read api key, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
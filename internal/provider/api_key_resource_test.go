@@ -4,9 +4,12 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"slices"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
@@ -62,3 +65,57 @@ resource "sendgrid_api_key" "test" {
 }
 `, name)
 }
+
+func TestChangedAPIKeyScopes(t *testing.T) {
+	ctx := context.Background()
+	set := func(scopes ...string) types.Set {
+		s, diags := types.SetValueFrom(ctx, types.StringType, scopes)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		return s
+	}
+
+	cases := []struct {
+		name  string
+		plan  types.Set
+		state types.Set
+		want  []string
+	}{
+		{
+			name:  "unchanged",
+			plan:  set("mail.send", "alerts.read"),
+			state: set("mail.send", "alerts.read"),
+		},
+		// SendGrid may return the scopes in a different order than configured.
+		{
+			name:  "reordered",
+			plan:  set("mail.send", "alerts.read", "templates.read"),
+			state: set("templates.read", "mail.send", "alerts.read"),
+		},
+		{
+			name:  "added",
+			plan:  set("mail.send", "alerts.read"),
+			state: set("mail.send"),
+			want:  []string{"mail.send", "alerts.read"},
+		},
+		{
+			name:  "default scopes are excluded",
+			plan:  set("mail.send", "2fa_required"),
+			state: set("alerts.read"),
+			want:  []string{"mail.send"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := changedAPIKeyScopes(ctx, c.plan, c.state)
+			slices.Sort(got)
+			want := slices.Clone(c.want)
+			slices.Sort(want)
+			if !slices.Equal(got, want) {
+				t.Errorf("got %v, want %v", got, want)
+			}
+		})
+	}
+}
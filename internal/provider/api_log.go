@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// sendgridAPIKeyPattern matches SendGrid API keys, which must never be written to the logs.
+var sendgridAPIKeyPattern = regexp.MustCompile(`SG\.[A-Za-z0-9_\-]+\.[A-Za-z0-9_\-]+`)
+
+// sensitiveLogFieldKeys are log fields whose values are always masked.
+var sensitiveLogFieldKeys = []string{"api_key", "authorization", "token", "password"}
+
+// apiLogContext returns ctx with the masking of sensitive values applied to its logger.
+func apiLogContext(ctx context.Context) context.Context {
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, sensitiveLogFieldKeys...)
+	ctx = tflog.MaskAllFieldValuesRegexes(ctx, sendgridAPIKeyPattern)
+	return tflog.MaskMessageRegexes(ctx, sendgridAPIKeyPattern)
+}
+
+// withAPILogFields returns ctx with the operation and the ID of the resource it acts on set as log fields,
+// so that every SendGrid API call made with ctx is logged with them. id is nil for singleton resources,
+// and is skipped while unknown, such as before a resource is created.
+func withAPILogFields(ctx context.Context, operation string, id attr.Value) context.Context {
+	ctx = tflog.SetField(ctx, "operation", operation)
+	if id == nil || id.IsNull() || id.IsUnknown() {
+		return ctx
+	}
+
+	switch v := id.(type) {
+	case types.String:
+		return tflog.SetField(ctx, "resource_id", v.ValueString())
+	case types.Int64:
+		return tflog.SetField(ctx, "resource_id", v.ValueInt64())
+	default:
+		return tflog.SetField(ctx, "resource_id", id.String())
+	}
+}
+
+// apiLogTransport logs every request sent to the SendGrid API and its response.
+// Only the method and the path are logged, so neither headers nor bodies, which may hold credentials, are written.
+type apiLogTransport struct {
+	base http.RoundTripper
+}
+
+func (t apiLogTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := apiLogContext(req.Context())
+	fields := map[string]interface{}{
+		"http_method": req.Method,
+		"http_path":   req.URL.Path,
+	}
+	tflog.Debug(ctx, "Sending SendGrid API request", fields)
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	fields["duration_ms"] = time.Since(start).Milliseconds()
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(ctx, "SendGrid API request failed", fields)
+		return resp, err
+	}
+
+	fields["http_status"] = resp.StatusCode
+	tflog.Debug(ctx, "Received SendGrid API response", fields)
+	return resp, nil
+}
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestAPILogTransportCreate(t *testing.T) {
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	rt, _ := newMockCustomFieldTransport()
+	r := &CustomFieldResource{client: newMockClient(apiLogTransport{base: rt})}
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	s := schemaResp.Schema
+
+	plan := tfsdk.Plan{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+		"id":             tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+		"name":           tftypes.NewValue(tftypes.String, "favorite_color"),
+		"type":           tftypes.NewValue(tftypes.String, "text"),
+		"adopt_existing": tftypes.NewValue(tftypes.Bool, false),
+	})}
+	resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: s, Raw: plan.Raw}}
+	r.Create(ctx, fwresource.CreateRequest{Plan: plan}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("unable to decode logs: %s", err)
+	}
+
+	var sent, received, attempt bool
+	for _, e := range entries {
+		switch e["@message"] {
+		case "Sending SendGrid API request":
+			sent = e["@level"] == "debug" && e["http_method"] == http.MethodPost && e["http_path"] == "/v3/contactdb/custom_fields" && e["operation"] == "create"
+		case "Received SendGrid API response":
+			received = e["@level"] == "debug" && e["http_status"] == float64(http.StatusCreated)
+		case "Calling SendGrid API":
+			attempt = e["@level"] == "trace" && e["rate_limit_group"] == string(rateLimitGroupContactDB) && e["retry_attempt"] == float64(0)
+		}
+	}
+	if !sent || !received || !attempt {
+		t.Errorf("got log entries %v, want the request, its response and the attempt to be logged", entries)
+	}
+
+	// The ID is known after the resource is created, so reads are logged with it.
+	output.Reset()
+	readResp := &fwresource.ReadResponse{State: resp.State}
+	r.Read(ctx, fwresource.ReadRequest{State: resp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", readResp.Diagnostics)
+	}
+
+	entries, err = tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("unable to decode logs: %s", err)
+	}
+	read := false
+	for _, e := range entries {
+		if e["@message"] == "Sending SendGrid API request" {
+			read = e["operation"] == "read" && e["resource_id"] == float64(1) && e["http_path"] == "/v3/contactdb/custom_fields/1"
+		}
+	}
+	if !read {
+		t.Errorf("got log entries %v, want the read request logged with the operation and the resource ID", entries)
+	}
+}
+
+func TestAPILogContextMasksSensitiveValues(t *testing.T) {
+	var output bytes.Buffer
+	ctx := apiLogContext(tflogtest.RootLogger(context.Background(), &output))
+
+	tflog.Debug(ctx, "calling with SG.abc.def", map[string]interface{}{
+		"token":   "secret",
+		"message": "key SG.abc.def rejected",
+	})
+
+	if got := output.String(); strings.Contains(got, "secret") || strings.Contains(got, "SG.abc.def") {
+		t.Errorf("got %s, want sensitive values masked", got)
+	}
+}
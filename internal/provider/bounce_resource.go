@@ -0,0 +1,280 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/i10416/sendgrid"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &bounceResource{}
+var _ resource.ResourceWithImportState = &bounceResource{}
+
+func newBounceResource() resource.Resource {
+	return &bounceResource{}
+}
+
+type bounceResource struct {
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
+}
+
+type bounceResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Email   types.String `tfsdk:"email"`
+	Created types.Int64  `tfsdk:"created"`
+	Reason  types.String `tfsdk:"reason"`
+	Status  types.String `tfsdk:"status"`
+}
+
+func (r *bounceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bounce"
+}
+
+func (r *bounceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Manages an address on the bounce suppression list.
+
+Bounces are recorded by SendGrid and cannot be created through the API, so creating this resource adopts an existing bounce into state.
+Destroying it deletes the bounce, which releases the address so that SendGrid delivers to it again.
+
+For more detailed information, please see the [SendGrid documentation](https://www.twilio.com/docs/sendgrid/api-reference/bounces).
+		`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The bounced email address",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email address that bounced",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"created": schema.Int64Attribute{
+				MarkdownDescription: "Unix timestamp of when the bounce was recorded",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"reason": schema.StringAttribute{
+				MarkdownDescription: "The reason the receiving server gave for the bounce",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The enhanced SMTP status code of the bounce. Example: 5.1.1",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *bounceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+	r.rateLimits = data.rateLimits
+}
+
+func (r *bounceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan bounceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAPILogFields(ctx, "create", plan.ID)
+
+	email := plan.Email.ValueString()
+	b, err := findBounce(ctx, r.rateLimits, r.client, email)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Creating bounce",
+			fmt.Sprintf("Unable to read bounce (email: %s), got error: %s", email, sendgridErrorDetail(err)),
+		)
+		return
+	}
+	if b == nil {
+		resp.Diagnostics.AddError(
+			"Creating bounce",
+			fmt.Sprintf("%s is not on the bounce suppression list. Bounces are recorded by SendGrid and cannot be created.", email),
+		)
+		return
+	}
+
+	plan = bounceModel(email, b)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *bounceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state bounceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAPILogFields(ctx, "read", state.ID)
+
+	email := state.Email.ValueString()
+	b, err := findBounce(ctx, r.rateLimits, r.client, email)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading bounce",
+			fmt.Sprintf("Unable to read bounce (email: %s), got error: %s", email, sendgridErrorDetail(err)),
+		)
+		return
+	}
+	if b == nil {
+		resp.Diagnostics.AddWarning(
+			"Reading bounce",
+			fmt.Sprintf("Bounce (email: %s) is no longer on the bounce suppression list and was removed from state.", email),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state = bounceModel(email, b)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *bounceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data bounceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAPILogFields(ctx, "update", data.ID)
+
+	// NOTE: email requires replacement and every other attribute is read from SendGrid, so there is nothing to update.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *bounceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state bounceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAPILogFields(ctx, "delete", state.ID)
+
+	email := state.Email.ValueString()
+	_, err := r.rateLimits.retry(ctx, rateLimitGroupSuppression, func() (interface{}, error) {
+		return nil, r.client.DeleteBounce(ctx, email)
+	})
+	// The bounce is already gone, so the address is already released.
+	if isNotFoundError(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Deleting bounce",
+			fmt.Sprintf("Unable to delete bounce (email: %s), got error: %s", email, sendgridErrorDetail(err)),
+		)
+		return
+	}
+}
+
+func (r *bounceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = withAPILogFields(ctx, "import", types.StringValue(req.ID))
+
+	email := req.ID
+	b, err := findBounce(ctx, r.rateLimits, r.client, email)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Importing bounce",
+			fmt.Sprintf("Unable to read bounce (email: %s), got error: %s", email, sendgridErrorDetail(err)),
+		)
+		return
+	}
+	if b == nil {
+		resp.Diagnostics.AddError(
+			"Importing bounce",
+			fmt.Sprintf("%s is not on the bounce suppression list.", email),
+		)
+		return
+	}
+
+	data := bounceModel(email, b)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// findBounce returns the bounce for email, or nil when the address is not on the bounce suppression list.
+func findBounce(ctx context.Context, rateLimits *rateLimitBuckets, client bounceLister, email string) (*sendgrid.Bounce, error) {
+	// NOTE: Re-execute after the re-executable time has elapsed when a rate limit occurs
+	res, err := rateLimits.retry(ctx, rateLimitGroupSuppression, func() (interface{}, error) {
+		return client.GetBounces(ctx, &sendgrid.SuppressionListOptions{Email: email})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	bounces, ok := res.([]sendgrid.Bounce)
+	if !ok {
+		return nil, fmt.Errorf("failed to assert type []sendgrid.Bounce")
+	}
+
+	for i := range bounces {
+		if strings.EqualFold(bounces[i].Email, email) {
+			return &bounces[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// bounceModel maps b to the resource model, keeping email as configured since addresses are compared case-insensitively.
+func bounceModel(email string, b *sendgrid.Bounce) bounceResourceModel {
+	return bounceResourceModel{
+		ID:      types.StringValue(email),
+		Email:   types.StringValue(email),
+		Created: types.Int64Value(b.Created),
+		Reason:  types.StringValue(b.Reason),
+		Status:  types.StringValue(b.Status),
+	}
+}
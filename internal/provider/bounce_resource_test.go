@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/i10416/sendgrid"
+)
+
+func TestBounceResourceWithMockTransport(t *testing.T) {
+	resourceName := "sendgrid_bounce.test"
+
+	rt, bounces := newMockBounceTransport(
+		map[string]any{"email": "bounced@example.com", "created": 1700000000, "reason": "550 5.1.1 unknown user", "status": "5.1.1"},
+		map[string]any{"email": "other@example.com", "created": 1700000001, "reason": "550 5.1.1 unknown user", "status": "5.1.1"},
+	)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactoriesWithTransport(rt),
+		CheckDestroy: func(_ *terraform.State) error {
+			if bounces.has("bounced@example.com") {
+				return errors.New("bounce still exists after destroy")
+			}
+			if !bounces.has("other@example.com") {
+				return errors.New("deleting a bounce released other addresses")
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testBounceResourceMockConfig("missing@example.com"),
+				ExpectError: regexp.MustCompile(`not on the bounce suppression list`),
+			},
+			// Create and Read testing
+			{
+				Config: testBounceResourceMockConfig("bounced@example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "bounced@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "created", "1700000000"),
+					resource.TestCheckResourceAttr(resourceName, "reason", "550 5.1.1 unknown user"),
+					resource.TestCheckResourceAttr(resourceName, "status", "5.1.1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// The bounce was deleted outside of Terraform, so it is removed from state and adopted again only if it bounces again.
+			{
+				PreConfig: func() {
+					bounces.remove("bounced@example.com")
+				},
+				Config:             testBounceResourceMockConfig("bounced@example.com"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				PreConfig: func() {
+					bounces.add(map[string]any{"email": "bounced@example.com", "created": 1700000002, "reason": "550 5.1.1 unknown user", "status": "5.1.1"})
+				},
+				Config: testBounceResourceMockConfig("bounced@example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "created", "1700000002"),
+				),
+			},
+		},
+	})
+}
+
+func TestFindBounce(t *testing.T) {
+	client := &mockBounceLister{
+		bounces: []sendgrid.Bounce{
+			{Email: "user@example.com", Created: 1700000000, Reason: "550 5.1.1 unknown user", Status: "5.1.1"},
+		},
+	}
+
+	got, err := findBounce(context.Background(), nil, client, "user@example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got == nil || got.Created != 1700000000 {
+		t.Errorf("got %+v, want the bounce of user@example.com", got)
+	}
+
+	got, err = findBounce(context.Background(), nil, client, "missing@example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got != nil {
+		t.Errorf("got %+v, want no bounce", got)
+	}
+}
+
+// mockBounces is the in-memory store behind the mock bounce endpoints.
+type mockBounces struct {
+	mu      sync.Mutex
+	bounces map[string]map[string]any
+}
+
+func (b *mockBounces) has(email string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	_, ok := b.bounces[email]
+	return ok
+}
+
+func (b *mockBounces) add(bounce map[string]any) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.bounces[bounce["email"].(string)] = bounce
+}
+
+func (b *mockBounces) remove(email string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.bounces, email)
+}
+
+// newMockBounceTransport returns a mock transport serving the bounce endpoints for the given bounces,
+// and the store it serves them from.
+func newMockBounceTransport(initial ...map[string]any) (*mockTransport, *mockBounces) {
+	rt := newMockTransport()
+	bounces := &mockBounces{bounces: map[string]map[string]any{}}
+	for _, b := range initial {
+		bounces.add(b)
+	}
+
+	rt.Handle(http.MethodGet, "/v3/suppression/bounces", func(req *http.Request) (int, any) {
+		email := req.URL.Query().Get("email")
+
+		bounces.mu.Lock()
+		defer bounces.mu.Unlock()
+		res := []map[string]any{}
+		for e, b := range bounces.bounces {
+			if email == "" || strings.EqualFold(e, email) {
+				res = append(res, b)
+			}
+		}
+		return http.StatusOK, res
+	})
+
+	for _, b := range initial {
+		email := b["email"].(string)
+		rt.Handle(http.MethodDelete, "/v3/suppression/bounces/"+email, func(_ *http.Request) (int, any) {
+			bounces.mu.Lock()
+			defer bounces.mu.Unlock()
+			if _, ok := bounces.bounces[email]; !ok {
+				return http.StatusNotFound, nil
+			}
+			delete(bounces.bounces, email)
+			return http.StatusNoContent, nil
+		})
+	}
+
+	return rt, bounces
+}
+
+func testBounceResourceMockConfig(email string) string {
+	return fmt.Sprintf(`
+provider "sendgrid" {
+	api_key = "SG.test"
+}
+
+resource "sendgrid_bounce" "test" {
+	email = "%s"
+}
+`, email)
+}
@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/i10416/sendgrid"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &bouncesDataSource{}
+	_ datasource.DataSourceWithConfigure = &bouncesDataSource{}
+)
+
+// bouncesPageSize is the number of bounces requested per page, which is the maximum SendGrid allows.
+const bouncesPageSize = 500
+
+// bounceLister lists the addresses on the bounce suppression list.
+type bounceLister interface {
+	GetBounces(ctx context.Context, opts *sendgrid.SuppressionListOptions) ([]sendgrid.Bounce, error)
+}
+
+func newBouncesDataSource() datasource.DataSource {
+	return &bouncesDataSource{}
+}
+
+type bouncesDataSource struct {
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
+}
+
+type bouncesDataSourceModel struct {
+	ID      types.String                 `tfsdk:"id"`
+	Bounces []bouncesDataSourceItemModel `tfsdk:"bounces"`
+}
+
+type bouncesDataSourceItemModel struct {
+	Email   types.String `tfsdk:"email"`
+	Created types.Int64  `tfsdk:"created"`
+	Reason  types.String `tfsdk:"reason"`
+	Status  types.String `tfsdk:"status"`
+}
+
+func (d *bouncesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bounces"
+}
+
+func (d *bouncesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = data.client
+	d.rateLimits = data.rateLimits
+}
+
+func (d *bouncesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Provides every address on the bounce suppression list.
+
+For more detailed information, please see the [SendGrid documentation](https://www.twilio.com/docs/sendgrid/api-reference/bounces/retrieve-all-bounces).
+		`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"bounces": schema.ListNestedAttribute{
+				MarkdownDescription: "All bounced addresses. Empty if there are no bounces.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"email": schema.StringAttribute{
+							MarkdownDescription: "The email address that bounced",
+							Computed:            true,
+						},
+						"created": schema.Int64Attribute{
+							MarkdownDescription: "Unix timestamp of when the bounce was recorded",
+							Computed:            true,
+						},
+						"reason": schema.StringAttribute{
+							MarkdownDescription: "The reason the receiving server gave for the bounce",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The enhanced SMTP status code of the bounce. Example: 5.1.1",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *bouncesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var s bouncesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &s)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bounces, err := listBounces(ctx, d.rateLimits, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading bounces",
+			fmt.Sprintf("Unable to get bounces, got error: %s", sendgridErrorDetail(err)),
+		)
+		return
+	}
+
+	s = bouncesDataSourceModel{
+		ID:      types.StringValue("bounces"),
+		Bounces: bounces,
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &s)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// listBounces returns every bounce, fetching the suppression list page by page.
+func listBounces(ctx context.Context, rateLimits *rateLimitBuckets, client bounceLister) ([]bouncesDataSourceItemModel, error) {
+	items := []bouncesDataSourceItemModel{}
+	for offset := 0; ; offset += bouncesPageSize {
+		// NOTE: Re-execute after the re-executable time has elapsed when a rate limit occurs
+		res, err := rateLimits.retry(ctx, rateLimitGroupSuppression, func() (interface{}, error) {
+			return client.GetBounces(ctx, &sendgrid.SuppressionListOptions{
+				Limit:  bouncesPageSize,
+				Offset: offset,
+			})
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		bounces, ok := res.([]sendgrid.Bounce)
+		if !ok {
+			return nil, fmt.Errorf("failed to assert type []sendgrid.Bounce")
+		}
+
+		for _, b := range bounces {
+			items = append(items, bounceItemModel(b))
+		}
+		if len(bounces) < bouncesPageSize {
+			return items, nil
+		}
+	}
+}
+
+func bounceItemModel(b sendgrid.Bounce) bouncesDataSourceItemModel {
+	return bouncesDataSourceItemModel{
+		Email:   types.StringValue(b.Email),
+		Created: types.Int64Value(b.Created),
+		Reason:  types.StringValue(b.Reason),
+		Status:  types.StringValue(b.Status),
+	}
+}
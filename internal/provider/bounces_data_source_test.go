@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/i10416/sendgrid"
+)
+
+type mockBounceLister struct {
+	bounces []sendgrid.Bounce
+	opts    []sendgrid.SuppressionListOptions
+}
+
+func (m *mockBounceLister) GetBounces(_ context.Context, opts *sendgrid.SuppressionListOptions) ([]sendgrid.Bounce, error) {
+	m.opts = append(m.opts, *opts)
+	if opts.Email != "" {
+		var bounces []sendgrid.Bounce
+		for _, b := range m.bounces {
+			if b.Email == opts.Email {
+				bounces = append(bounces, b)
+			}
+		}
+		return bounces, nil
+	}
+	if opts.Offset >= len(m.bounces) {
+		return []sendgrid.Bounce{}, nil
+	}
+	end := min(opts.Offset+opts.Limit, len(m.bounces))
+	return m.bounces[opts.Offset:end], nil
+}
+
+func TestAccBouncesDataSource(t *testing.T) {
+	resourceName := "data.sendgrid_bounces.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `data "sendgrid_bounces" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "bounces"),
+					resource.TestCheckResourceAttrSet(resourceName, "bounces.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestListBounces(t *testing.T) {
+	many := make([]sendgrid.Bounce, bouncesPageSize+1)
+	for i := range many {
+		many[i] = sendgrid.Bounce{Email: fmt.Sprintf("user%d@example.com", i), Created: int64(i), Reason: "550 5.1.1 unknown user", Status: "5.1.1"}
+	}
+
+	cases := []struct {
+		name      string
+		client    *mockBounceLister
+		wantLen   int
+		wantPages int
+	}{
+		{name: "empty", client: &mockBounceLister{}, wantPages: 1},
+		{name: "single page", client: &mockBounceLister{bounces: many[:2]}, wantLen: 2, wantPages: 1},
+		{name: "multiple pages", client: &mockBounceLister{bounces: many}, wantLen: bouncesPageSize + 1, wantPages: 2},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := listBounces(context.Background(), nil, c.client)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if len(got) != c.wantLen {
+				t.Errorf("got %d bounces, want %d", len(got), c.wantLen)
+			}
+			if len(c.client.opts) != c.wantPages {
+				t.Errorf("got %d pages, want %d", len(c.client.opts), c.wantPages)
+			}
+		})
+	}
+}
+
+func TestListBouncesMapping(t *testing.T) {
+	client := &mockBounceLister{
+		bounces: []sendgrid.Bounce{
+			{Email: "user@example.com", Created: 1700000000, Reason: "550 5.1.1 unknown user", Status: "5.1.1"},
+		},
+	}
+
+	got, err := listBounces(context.Background(), nil, client)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := []bouncesDataSourceItemModel{
+		{
+			Email:   types.StringValue("user@example.com"),
+			Created: types.Int64Value(1700000000),
+			Reason:  types.StringValue("550 5.1.1 unknown user"),
+			Status:  types.StringValue("5.1.1"),
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
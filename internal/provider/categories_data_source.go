@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/i10416/sendgrid"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &categoriesDataSource{}
+	_ datasource.DataSourceWithConfigure = &categoriesDataSource{}
+)
+
+// categoriesPageSize is the number of categories requested per page.
+const categoriesPageSize = 100
+
+// categoryPager lists the categories of the account page by page.
+type categoryPager interface {
+	GetCategoriesPage(ctx context.Context, prefix string, limit, offset int) ([]string, error)
+}
+
+func newCategoriesDataSource() datasource.DataSource {
+	return &categoriesDataSource{}
+}
+
+type categoriesDataSource struct {
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
+}
+
+type categoriesDataSourceModel struct {
+	ID         types.String   `tfsdk:"id"`
+	Prefix     types.String   `tfsdk:"prefix"`
+	Categories []types.String `tfsdk:"categories"`
+}
+
+func (d *categoriesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_categories"
+}
+
+func (d *categoriesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = data.client
+	d.rateLimits = data.rateLimits
+}
+
+func (d *categoriesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Provides the categories used on the account, such as the categories of single sends or the categories to query statistics for.
+
+For more detailed information, please see the [SendGrid documentation](https://www.twilio.com/docs/sendgrid/api-reference/categories/retrieve-all-categories).
+		`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"prefix": schema.StringAttribute{
+				MarkdownDescription: "Only provide the categories starting with this prefix. Every category is provided if omitted.",
+				Optional:            true,
+			},
+			"categories": schema.ListAttribute{
+				MarkdownDescription: "The names of the categories. Empty if there are no categories.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *categoriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var s categoriesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &s)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prefix := s.Prefix.ValueString()
+	categories, err := listCategories(ctx, d.rateLimits, sendgridClient{d.client}, prefix)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading categories",
+			fmt.Sprintf("Unable to get categories, got error: %s", sendgridErrorDetail(err)),
+		)
+		return
+	}
+
+	s.ID = types.StringValue("categories")
+	s.Categories = categories
+	resp.Diagnostics.Append(resp.State.Set(ctx, &s)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// listCategories returns every category starting with prefix, fetching the categories page by page.
+func listCategories(ctx context.Context, rateLimits *rateLimitBuckets, client categoryPager, prefix string) ([]types.String, error) {
+	names, err := paginateAll(ctx, categoriesPageSize, func(ctx context.Context, limit, offset int) ([]string, error) {
+		// NOTE: Re-execute after the re-executable time has elapsed when a rate limit occurs
+		res, err := rateLimits.retry(ctx, rateLimitGroupCategories, func() (interface{}, error) {
+			return client.GetCategoriesPage(ctx, prefix, limit, offset)
+		})
+		if err != nil {
+			return nil, err
+		}
+		page, ok := res.([]string)
+		if !ok {
+			return nil, fmt.Errorf("failed to assert type []string")
+		}
+		return page, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	categories := []types.String{}
+	for _, name := range names {
+		// NOTE: SendGrid filters by prefix too, but the prefix is checked again so that the result
+		//       does not depend on how SendGrid matches the category query parameter.
+		if strings.HasPrefix(name, prefix) {
+			categories = append(categories, types.StringValue(name))
+		}
+	}
+	return categories, nil
+}
@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+type mockCategoryPager struct {
+	categories []string
+	pages      int
+}
+
+func (m *mockCategoryPager) GetCategoriesPage(_ context.Context, prefix string, limit, offset int) ([]string, error) {
+	m.pages++
+	var matched []string
+	for _, c := range m.categories {
+		if strings.HasPrefix(c, prefix) {
+			matched = append(matched, c)
+		}
+	}
+	if offset >= len(matched) {
+		return []string{}, nil
+	}
+	return matched[offset:min(offset+limit, len(matched))], nil
+}
+
+func TestAccCategoriesDataSource(t *testing.T) {
+	resourceName := "data.sendgrid_categories.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `data "sendgrid_categories" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "categories"),
+					resource.TestCheckResourceAttrSet(resourceName, "categories.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestListCategories(t *testing.T) {
+	many := make([]string, categoriesPageSize+1)
+	for i := range many {
+		many[i] = fmt.Sprintf("newsletter-%03d", i)
+	}
+
+	cases := []struct {
+		name       string
+		categories []string
+		prefix     string
+		want       []types.String
+		wantPages  int
+	}{
+		{name: "empty", want: []types.String{}, wantPages: 1},
+		{
+			name:       "every category",
+			categories: []string{"newsletter", "receipt"},
+			want:       []types.String{types.StringValue("newsletter"), types.StringValue("receipt")},
+			wantPages:  1,
+		},
+		{
+			name:       "prefix",
+			categories: []string{"newsletter", "receipt", "news-weekly", "weekly-news"},
+			prefix:     "news",
+			want:       []types.String{types.StringValue("newsletter"), types.StringValue("news-weekly")},
+			wantPages:  1,
+		},
+		{name: "multiple pages", categories: many, prefix: "newsletter-", wantPages: 2},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			client := &mockCategoryPager{categories: c.categories}
+			got, err := listCategories(context.Background(), nil, client, c.prefix)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if c.want != nil && !reflect.DeepEqual(got, c.want) {
+				t.Errorf("got %v, want %v", got, c.want)
+			}
+			if c.want == nil && len(got) != len(c.categories) {
+				t.Errorf("got %d categories, want %d", len(got), len(c.categories))
+			}
+			if client.pages != c.wantPages {
+				t.Errorf("got %d pages, want %d", client.pages, c.wantPages)
+			}
+		})
+	}
+}
+
+func TestListCategoriesIgnoresOtherPrefixes(t *testing.T) {
+	// SendGrid may match the category query parameter loosely, so categories without the prefix are dropped.
+	rt := newMockTransport()
+	rt.Handle(http.MethodGet, "/v3/categories", func(_ *http.Request) (int, any) {
+		return http.StatusOK, []map[string]any{{"category": "newsletter"}, {"category": "weekly-news"}}
+	})
+
+	got, err := listCategories(context.Background(), nil, sendgridClient{newMockClient(rt)}, "news")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if want := []types.String{types.StringValue("newsletter")}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	q := rt.Requests()[0].URL.Query()
+	if q.Get("category") != "news" || q.Get("limit") != strconv.Itoa(categoriesPageSize) || q.Get("offset") != "0" {
+		t.Errorf("got query %s, want the prefix and the first page", rt.Requests()[0].URL.RawQuery)
+	}
+}
@@ -24,7 +24,8 @@ func newClickTrackingSettingsDataSource() datasource.DataSource {
 }
 
 type clickTrackingSettingsDataSource struct {
-	client *sendgrid.Client
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
 }
 
 type clickTrackingSettingsDataSourceModel struct {
@@ -42,16 +43,17 @@ func (d *clickTrackingSettingsDataSource) Configure(ctx context.Context, req dat
 		return
 	}
 
-	client, ok := req.ProviderData.(*sendgrid.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = data.client
+	d.rateLimits = data.rateLimits
 }
 
 func (d *clickTrackingSettingsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
@@ -85,7 +87,7 @@ func (d *clickTrackingSettingsDataSource) Read(ctx context.Context, req datasour
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading click tracking settings",
-			fmt.Sprintf("Unable to get click tracking settings, err: %s", err.Error()),
+			fmt.Sprintf("Unable to get click tracking settings, err: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
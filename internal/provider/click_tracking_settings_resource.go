@@ -23,7 +23,8 @@ func newClickTrackingSettingsResource() resource.Resource {
 }
 
 type clickTrackingSettingsResource struct {
-	client *sendgrid.Client
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
 }
 
 type clickTrackingSettingsResourceModel struct {
@@ -50,7 +51,7 @@ Click tracking helps you understand how users are engaging with your communicati
 				Default:             booldefault.StaticBool(false),
 			},
 			"enable_text": schema.BoolAttribute{
-				MarkdownDescription: "Indicates if click tracking is enabled for plain text emails.",
+				MarkdownDescription: "Indicates if click tracking is enabled for plain text emails. SendGrid only allows this to be changed in the Mail Send request, so it is read from the API and is not affected by `enabled`.",
 				Computed:            true,
 			},
 		},
@@ -62,133 +63,66 @@ func (r *clickTrackingSettingsResource) Configure(ctx context.Context, req resou
 		return
 	}
 
-	client, ok := req.ProviderData.(*sendgrid.Client)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+	r.rateLimits = data.rateLimits
 }
 
-func (r *clickTrackingSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan clickTrackingSettingsResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	input := &sendgrid.InputUpdateClickTrackingSettings{
-		Enabled: plan.Enabled.ValueBool(),
-	}
-	o, err := r.client.UpdateClickTrackingSettings(ctx, input)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Creating click tracking settings",
-			fmt.Sprintf("Unable to update click tracking settings, got error: %s", err),
-		)
-		return
+func (r *clickTrackingSettingsResource) singleton() singletonResource[clickTrackingSettingsResourceModel] {
+	return singletonResource[clickTrackingSettingsResourceModel]{
+		name:     "click tracking settings",
+		typeName: "sendgrid_click_tracking_settings",
+		get: func(ctx context.Context) (clickTrackingSettingsResourceModel, error) {
+			o, err := r.client.GetClickTrackingSettings(ctx)
+			if err != nil {
+				return clickTrackingSettingsResourceModel{}, err
+			}
+			return clickTrackingSettingsResourceModel{
+				Enabled:    types.BoolValue(o.Enabled),
+				EnableText: types.BoolValue(o.EnableText),
+			}, nil
+		},
+		set: func(ctx context.Context, plan clickTrackingSettingsResourceModel) (clickTrackingSettingsResourceModel, error) {
+			o, err := r.client.UpdateClickTrackingSettings(ctx, &sendgrid.InputUpdateClickTrackingSettings{
+				Enabled: plan.Enabled.ValueBool(),
+			})
+			if err != nil {
+				return clickTrackingSettingsResourceModel{}, err
+			}
+			return clickTrackingSettingsResourceModel{
+				Enabled:    types.BoolValue(o.Enabled),
+				EnableText: types.BoolValue(o.EnableText),
+			}, nil
+		},
 	}
+}
 
-	plan = clickTrackingSettingsResourceModel{
-		Enabled:    types.BoolValue(o.Enabled),
-		EnableText: types.BoolValue(o.EnableText),
-	}
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+func (r *clickTrackingSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.singleton().create(ctx, req, resp)
 }
 
 func (r *clickTrackingSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state clickTrackingSettingsResourceModel
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	o, err := r.client.GetClickTrackingSettings(ctx)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Reading click tracking settings",
-			fmt.Sprintf("Unable to read click tracking settings, got error: %s", err),
-		)
-		return
-	}
-
-	state = clickTrackingSettingsResourceModel{
-		Enabled:    types.BoolValue(o.Enabled),
-		EnableText: types.BoolValue(o.EnableText),
-	}
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+	r.singleton().read(ctx, req, resp)
 }
 
 func (r *clickTrackingSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data, state clickTrackingSettingsResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	input := &sendgrid.InputUpdateClickTrackingSettings{}
-	if !data.Enabled.IsNull() && data.Enabled.ValueBool() != state.Enabled.ValueBool() {
-		input.Enabled = data.Enabled.ValueBool()
-	}
-
-	o, err := r.client.UpdateClickTrackingSettings(ctx, input)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Updating click tracking settings",
-			fmt.Sprintf("Unable to update click tracking settings, got error: %s", err),
-		)
-		return
-	}
-
-	data = clickTrackingSettingsResourceModel{
-		Enabled:    types.BoolValue(o.Enabled),
-		EnableText: types.BoolValue(o.EnableText),
-	}
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+	r.singleton().update(ctx, req, resp)
 }
 
 func (r *clickTrackingSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state clickTrackingSettingsResourceModel
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+	r.singleton().delete(ctx, req, resp)
 }
 
 func (r *clickTrackingSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	var data clickTrackingSettingsResourceModel
-
-	o, err := r.client.GetClickTrackingSettings(ctx)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Importing click tracking settings",
-			fmt.Sprintf("Unable to read click tracking settings, got error: %s", err),
-		)
-		return
-	}
-
-	data = clickTrackingSettingsResourceModel{
-		Enabled:    types.BoolValue(o.Enabled),
-		EnableText: types.BoolValue(o.EnableText),
-	}
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+	r.singleton().importState(ctx, req, resp)
 }
@@ -4,7 +4,11 @@
 package provider
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -31,6 +35,12 @@ func TestAccClickTrackingSettingsResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateIdFunc: importClickTrackingSettingsStateIdFunc(),
 			},
+			// ImportState testing with the singleton import ID
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: singletonImportID,
+			},
 		},
 	})
 }
@@ -48,3 +58,87 @@ func importClickTrackingSettingsStateIdFunc() resource.ImportStateIdFunc {
 		return "", nil
 	}
 }
+
+func TestClickTrackingSettingsResourceWithMockTransport(t *testing.T) {
+	resourceName := "sendgrid_click_tracking_settings.test"
+
+	for _, enabled := range []bool{false, true} {
+		for _, enableText := range []bool{false, true} {
+			t.Run(fmt.Sprintf("enabled=%t,enable_text=%t", enabled, enableText), func(t *testing.T) {
+				rt := newMockClickTrackingTransport(!enabled, enableText)
+
+				resource.UnitTest(t, resource.TestCase{
+					ProtoV6ProviderFactories: testProtoV6ProviderFactoriesWithTransport(rt),
+					Steps: []resource.TestStep{
+						// enable_text is kept as SendGrid reports it, independently of enabled.
+						{
+							Config: testClickTrackingSettingsResourceMockConfig(enabled),
+							Check: resource.ComposeAggregateTestCheckFunc(
+								resource.TestCheckResourceAttr(resourceName, "enabled", strconv.FormatBool(enabled)),
+								resource.TestCheckResourceAttr(resourceName, "enable_text", strconv.FormatBool(enableText)),
+							),
+						},
+						{
+							ResourceName:  resourceName,
+							ImportState:   true,
+							ImportStateId: singletonImportID,
+							ImportStateCheck: func(states []*terraform.InstanceState) error {
+								if len(states) != 1 {
+									return fmt.Errorf("got %d imported states, want 1", len(states))
+								}
+								if got := states[0].Attributes["enable_text"]; got != strconv.FormatBool(enableText) {
+									return fmt.Errorf("got imported enable_text %s, want %t", got, enableText)
+								}
+								return nil
+							},
+						},
+					},
+				})
+			})
+		}
+	}
+}
+
+// newMockClickTrackingTransport returns a mock transport serving the click tracking settings endpoints,
+// starting from the given settings. Like SendGrid, PATCH only updates enabled.
+func newMockClickTrackingTransport(enabled, enableText bool) *mockTransport {
+	rt := newMockTransport()
+
+	var mu sync.Mutex
+	settings := func() map[string]any {
+		return map[string]any{"enabled": enabled, "enable_text": enableText}
+	}
+
+	rt.Handle(http.MethodGet, "/v3/tracking_settings/click", func(_ *http.Request) (int, any) {
+		mu.Lock()
+		defer mu.Unlock()
+		return http.StatusOK, settings()
+	})
+	rt.Handle(http.MethodPatch, "/v3/tracking_settings/click", func(req *http.Request) (int, any) {
+		var in struct {
+			Enabled bool `json:"enabled"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&in); err != nil {
+			return http.StatusBadRequest, nil
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		enabled = in.Enabled
+		return http.StatusOK, settings()
+	})
+
+	return rt
+}
+
+func testClickTrackingSettingsResourceMockConfig(enabled bool) string {
+	return fmt.Sprintf(`
+provider "sendgrid" {
+	api_key = "SG.test"
+}
+
+resource "sendgrid_click_tracking_settings" "test" {
+	enabled = %t
+}
+`, enabled)
+}
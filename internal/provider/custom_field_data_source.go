@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/i10416/sendgrid"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &customFieldDataSource{}
+	_ datasource.DataSourceWithConfigure = &customFieldDataSource{}
+)
+
+func newCustomFieldDataSource() datasource.DataSource {
+	return &customFieldDataSource{}
+}
+
+type customFieldDataSource struct {
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
+}
+
+type customFieldDataSourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Type     types.String `tfsdk:"type"`
+	Reserved types.Bool   `tfsdk:"reserved"`
+}
+
+func (d *customFieldDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_field"
+}
+
+func (d *customFieldDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = data.client
+	d.rateLimits = data.rateLimits
+}
+
+func (d *customFieldDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Provides a custom field defined on the contact database, looked up by name.
+
+Reserved fields such as ` + "`email`" + ` are created by SendGrid. They are returned with ` + "`reserved`" + ` set to true when SendGrid lists them along with the custom fields, and are not found otherwise, since SendGrid does not provide their ID.
+
+SendGrid does not report how many contacts have a value for a custom field, so the data source cannot tell whether a field is safe to delete.
+		`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the custom field.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the custom field.",
+				Required:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the custom field. Can be one of `text`, `number` or `date`.",
+				Computed:            true,
+			},
+			"reserved": schema.BoolAttribute{
+				MarkdownDescription: "Whether the field is a reserved field created by SendGrid, which cannot be managed by `sendgrid_custom_field`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *customFieldDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var s customFieldDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &s)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := s.Name.ValueString()
+	data, found, err := findCustomField(ctx, sendgridClient{d.client}, name)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading custom field",
+			fmt.Sprintf("Unable to get custom fields, got error: %s", sendgridErrorDetail(err)),
+		)
+		return
+	}
+	if !found {
+		detail := fmt.Sprintf("Unable to find custom field (name: %s)", name)
+		if isReservedCustomField(name) {
+			detail += ". It is a reserved field, which SendGrid does not list along with the custom fields of this account"
+		}
+		resp.Diagnostics.AddError("Reading custom field", detail)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// findCustomField looks up the custom field named name in the list of custom fields.
+// Reserved fields are only found when the list includes them, since their ID is not known otherwise.
+func findCustomField(ctx context.Context, client CustomFieldLister, name string) (customFieldDataSourceModel, bool, error) {
+	fields, err := client.GetCustomFields(ctx)
+	if err != nil {
+		return customFieldDataSourceModel{}, false, err
+	}
+
+	for _, f := range fields {
+		if f.Name == name {
+			return customFieldDataSourceModel{
+				ID:       types.StringValue(strconv.FormatInt(f.ID, 10)),
+				Name:     types.StringValue(name),
+				Type:     types.StringValue(f.Type),
+				Reserved: types.BoolValue(isReservedCustomField(name)),
+			}, true, nil
+		}
+	}
+
+	return customFieldDataSourceModel{}, false, nil
+}
@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/i10416/sendgrid"
+)
+
+func TestAccCustomFieldDataSource(t *testing.T) {
+	resourceName := "data.sendgrid_custom_field.test"
+
+	name := fmt.Sprintf("test_acc_%s", acctest.RandStringFromCharSet(16, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccCustomFieldDataSourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "sendgrid_custom_field.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "type", "number"),
+					resource.TestCheckResourceAttr(resourceName, "reserved", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestFindCustomField(t *testing.T) {
+	client := &mockCustomFieldLister{
+		fields: []sendgrid.CustomField{
+			{ID: 1, Name: "favorite_color", Type: "text"},
+			{ID: 2, Name: "last_emailed", Type: "date"},
+		},
+	}
+
+	cases := []struct {
+		name      string
+		wantFound bool
+		want      customFieldDataSourceModel
+	}{
+		{
+			name:      "favorite_color",
+			wantFound: true,
+			want: customFieldDataSourceModel{
+				ID:       types.StringValue("1"),
+				Name:     types.StringValue("favorite_color"),
+				Type:     types.StringValue("text"),
+				Reserved: types.BoolValue(false),
+			},
+		},
+		// Reserved fields listed by the endpoint keep their id and type.
+		{
+			name:      "last_emailed",
+			wantFound: true,
+			want: customFieldDataSourceModel{
+				ID:       types.StringValue("2"),
+				Name:     types.StringValue("last_emailed"),
+				Type:     types.StringValue("date"),
+				Reserved: types.BoolValue(true),
+			},
+		},
+		// Reserved fields not listed by the endpoint have no ID, so they are not found.
+		{
+			name: "email",
+		},
+		{
+			name: "missing",
+		},
+	}
+
+	for _, c := range cases {
+		got, found, err := findCustomField(context.Background(), client, c.name)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if found != c.wantFound {
+			t.Errorf("findCustomField(%q) found = %t, want %t", c.name, found, c.wantFound)
+		}
+		if found && got != c.want {
+			t.Errorf("findCustomField(%q) = %+v, want %+v", c.name, got, c.want)
+		}
+	}
+}
+
+func testAccCustomFieldDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_custom_field" "test" {
+	name = "%[1]s"
+	type = "number"
+}
+
+data "sendgrid_custom_field" "test" {
+	name = sendgrid_custom_field.test.name
+}
+`, name)
+}
@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/i10416/sendgrid"
+)
+
+// CustomFieldLister lists every custom field defined on the account.
+type CustomFieldLister interface {
+	GetCustomFields(ctx context.Context) ([]sendgrid.CustomField, error)
+}
+
+// customFieldGetter fetches a single custom field by id and can list every custom field.
+type customFieldGetter interface {
+	CustomFieldLister
+	GetCustomField(ctx context.Context, id int64) (*sendgrid.CustomField, error)
+}
+
+// getCustomFieldByID fetches the custom field with the given id. When the direct fetch fails with 404,
+// it falls back to listing all custom fields and picking the one with a matching id,
+// because the id endpoint does not resolve every field that the list endpoint returns.
+// The original 404 error is returned if the list has no match either.
+func getCustomFieldByID(ctx context.Context, client customFieldGetter, id int64) (*sendgrid.CustomField, error) {
+	o, err := client.GetCustomField(ctx, id)
+	if !isNotFoundError(err) {
+		return o, err
+	}
+
+	fields, listErr := client.GetCustomFields(ctx)
+	if listErr != nil {
+		return nil, listErr
+	}
+	for i := range fields {
+		if fields[i].ID == id {
+			return &fields[i], nil
+		}
+	}
+
+	return nil, err
+}
+
+// findAdoptableCustomField looks up the custom field with the given name so that it can be adopted
+// instead of created. It returns nil when no such field exists, and an error when the field
+// exists with a different type, because custom field types are immutable.
+func findAdoptableCustomField(ctx context.Context, client CustomFieldLister, name, typ string) (*sendgrid.CustomField, error) {
+	if isReservedCustomField(name) {
+		return nil, fmt.Errorf("%q is a reserved field and cannot be managed", name)
+	}
+
+	fields, err := client.GetCustomFields(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range fields {
+		if fields[i].Name != name {
+			continue
+		}
+		if fields[i].Type != typ {
+			return nil, fmt.Errorf("CustomField %q (id: %d) already exists with type %q, but the configured type is %q", name, fields[i].ID, fields[i].Type, typ)
+		}
+		return &fields[i], nil
+	}
+
+	return nil, nil
+}
+
+// ImportAction describes what an import preview would do for a custom field name.
+type ImportAction string
+
+const (
+	// ImportActionImport means the field exists and can be imported with ImportPlan.ImportID.
+	ImportActionImport ImportAction = "import"
+	// ImportActionReserved means the name belongs to a reserved field, which cannot be managed.
+	ImportActionReserved ImportAction = "reserved"
+	// ImportActionNotFound means no field with the name exists, so there is nothing to import.
+	ImportActionNotFound ImportAction = "not_found"
+)
+
+// ImportPlan is the preview of importing a single custom field by name.
+type ImportPlan struct {
+	Name     string
+	Action   ImportAction
+	ID       int64
+	Type     string
+	ImportID string
+}
+
+// PreviewCustomFieldImports resolves custom field names to their ids and returns the import actions
+// that `terraform import sendgrid_custom_field.<name> <id>` would perform, without changing anything.
+// The plans are returned in the same order as names.
+func PreviewCustomFieldImports(ctx context.Context, client CustomFieldLister, names []string) ([]ImportPlan, error) {
+	fields, err := client.GetCustomFields(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byName := map[string]sendgrid.CustomField{}
+	for _, f := range fields {
+		byName[f.Name] = f
+	}
+
+	plans := make([]ImportPlan, 0, len(names))
+	for _, name := range names {
+		if isReservedCustomField(name) {
+			plans = append(plans, ImportPlan{
+				Name:   name,
+				Action: ImportActionReserved,
+			})
+			continue
+		}
+
+		f, ok := byName[name]
+		if !ok {
+			plans = append(plans, ImportPlan{
+				Name:   name,
+				Action: ImportActionNotFound,
+			})
+			continue
+		}
+
+		plans = append(plans, ImportPlan{
+			Name:     name,
+			Action:   ImportActionImport,
+			ID:       f.ID,
+			Type:     f.Type,
+			ImportID: strconv.FormatInt(f.ID, 10),
+		})
+	}
+
+	return plans, nil
+}
@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/i10416/sendgrid"
+)
+
+type mockCustomFieldLister struct {
+	fields []sendgrid.CustomField
+	err    error
+}
+
+func (m *mockCustomFieldLister) GetCustomFields(_ context.Context) ([]sendgrid.CustomField, error) {
+	return m.fields, m.err
+}
+
+type mockCustomFieldGetter struct {
+	mockCustomFieldLister
+	field  *sendgrid.CustomField
+	getErr error
+	listed bool
+}
+
+func (m *mockCustomFieldGetter) GetCustomField(_ context.Context, _ int64) (*sendgrid.CustomField, error) {
+	return m.field, m.getErr
+}
+
+func (m *mockCustomFieldGetter) GetCustomFields(ctx context.Context) ([]sendgrid.CustomField, error) {
+	m.listed = true
+	return m.mockCustomFieldLister.GetCustomFields(ctx)
+}
+
+func TestPreviewCustomFieldImports(t *testing.T) {
+	client := &mockCustomFieldLister{
+		fields: []sendgrid.CustomField{
+			{ID: 1, Name: "favorite_color", Type: "text"},
+			{ID: 2, Name: "age", Type: "number"},
+		},
+	}
+
+	got, err := PreviewCustomFieldImports(context.Background(), client, []string{"age", "missing", "email", "favorite_color"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := []ImportPlan{
+		{Name: "age", Action: ImportActionImport, ID: 2, Type: "number", ImportID: "2"},
+		{Name: "missing", Action: ImportActionNotFound},
+		{Name: "email", Action: ImportActionReserved},
+		{Name: "favorite_color", Action: ImportActionImport, ID: 1, Type: "text", ImportID: "1"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestPreviewCustomFieldImportsListError(t *testing.T) {
+	want := errors.New("boom")
+	_, err := PreviewCustomFieldImports(context.Background(), &mockCustomFieldLister{err: want}, []string{"age"})
+	if !errors.Is(err, want) {
+		t.Errorf("got error %v, want %v", err, want)
+	}
+}
+
+func TestGetCustomFieldByID(t *testing.T) {
+	notFound := statusCodeError{code: http.StatusNotFound}
+	fields := []sendgrid.CustomField{
+		{ID: 1, Name: "favorite_color", Type: "text"},
+		{ID: 2, Name: "age", Type: "number"},
+	}
+
+	cases := []struct {
+		name       string
+		client     *mockCustomFieldGetter
+		want       *sendgrid.CustomField
+		wantErr    error
+		wantListed bool
+	}{
+		{
+			name:   "direct",
+			client: &mockCustomFieldGetter{field: &sendgrid.CustomField{ID: 2, Name: "age", Type: "number"}},
+			want:   &sendgrid.CustomField{ID: 2, Name: "age", Type: "number"},
+		},
+		{
+			name:       "list fallback",
+			client:     &mockCustomFieldGetter{mockCustomFieldLister: mockCustomFieldLister{fields: fields}, getErr: notFound},
+			want:       &sendgrid.CustomField{ID: 2, Name: "age", Type: "number"},
+			wantListed: true,
+		},
+		{
+			name:       "missing from list",
+			client:     &mockCustomFieldGetter{mockCustomFieldLister: mockCustomFieldLister{fields: fields[:1]}, getErr: notFound},
+			wantErr:    notFound,
+			wantListed: true,
+		},
+		{
+			name:       "list error",
+			client:     &mockCustomFieldGetter{mockCustomFieldLister: mockCustomFieldLister{err: errors.New("boom")}, getErr: notFound},
+			wantErr:    errors.New("boom"),
+			wantListed: true,
+		},
+		// Errors other than 404 are not retried through the list endpoint.
+		{
+			name:    "other error",
+			client:  &mockCustomFieldGetter{mockCustomFieldLister: mockCustomFieldLister{fields: fields}, getErr: statusCodeError{code: http.StatusBadRequest}},
+			wantErr: statusCodeError{code: http.StatusBadRequest},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := getCustomFieldByID(context.Background(), c.client, 2)
+			if c.wantErr != nil {
+				if err == nil || err.Error() != c.wantErr.Error() {
+					t.Fatalf("got error %v, want %v", err, c.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("got %+v, want %+v", got, c.want)
+			}
+			if c.client.listed != c.wantListed {
+				t.Errorf("listed = %t, want %t", c.client.listed, c.wantListed)
+			}
+		})
+	}
+}
+
+func TestFindAdoptableCustomField(t *testing.T) {
+	fields := []sendgrid.CustomField{
+		{ID: 1, Name: "favorite_color", Type: "text"},
+		{ID: 2, Name: "age", Type: "number"},
+	}
+
+	cases := []struct {
+		name    string
+		lister  *mockCustomFieldLister
+		field   string
+		typ     string
+		want    *sendgrid.CustomField
+		wantErr bool
+	}{
+		{name: "adopt", lister: &mockCustomFieldLister{fields: fields}, field: "age", typ: "number", want: &fields[1]},
+		{name: "not found", lister: &mockCustomFieldLister{fields: fields}, field: "birthday", typ: "date"},
+		{name: "type mismatch", lister: &mockCustomFieldLister{fields: fields}, field: "age", typ: "text", wantErr: true},
+		{name: "reserved", lister: &mockCustomFieldLister{fields: fields}, field: "email", typ: "text", wantErr: true},
+		{name: "list error", lister: &mockCustomFieldLister{err: errors.New("boom")}, field: "age", typ: "number", wantErr: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := findAdoptableCustomField(context.Background(), c.lister, c.field, c.typ)
+			if (err != nil) != c.wantErr {
+				t.Fatalf("got error %v, want error %t", err, c.wantErr)
+			}
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("got %+v, want %+v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestGetCustomFieldByIDWithMockClient(t *testing.T) {
+	ctx := context.Background()
+	// The mock serves the id endpoint for the ids 1 to 3 only, so the fourth field is only found by listing.
+	rt, _ := newMockCustomFieldTransport()
+	client := sendgridClient{newMockClient(rt)}
+	for i := 0; i < 4; i++ {
+		if _, err := client.CreateCustomField(ctx, &sendgrid.InputCreateCustomField{Name: fmt.Sprintf("field_%d", i+1), Type: "text"}); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	got, err := getCustomFieldByID(ctx, client, 4)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got.ID != 4 || got.Name != "field_4" {
+		t.Errorf("got %+v, want field_4 with id 4", got)
+	}
+
+	if _, err := getCustomFieldByID(ctx, client, 5); !isNotFoundError(err) {
+		t.Errorf("got error %v, want the 404 of the id endpoint", err)
+	}
+}
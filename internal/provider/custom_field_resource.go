@@ -6,11 +6,16 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"slices"
 	"strconv"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -22,18 +27,32 @@ import (
 var _ resource.Resource = &CustomFieldResource{}
 var _ resource.ResourceWithImportState = &CustomFieldResource{}
 
+// Reserved fields are created by SendGrid for every contact database and cannot be managed as custom fields.
+var reservedCustomFieldNames = []string{
+	"email",
+	"first_name",
+	"last_name",
+	"created_at",
+	"updated_at",
+	"last_emailed",
+	"last_clicked",
+	"last_opened",
+}
+
 func newCustomFieldResource() resource.Resource {
 	return &CustomFieldResource{}
 }
 
 type CustomFieldResource struct {
-	client *sendgrid.Client
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
 }
 
 type CustomFieldResourceModel struct {
-	ID   types.Int64  `tfsdk:"id"`
-	Name types.String `tfsdk:"name"`
-	Type types.String `tfsdk:"type"`
+	ID            types.Int64  `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Type          types.String `tfsdk:"type"`
+	AdoptExisting types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (r *CustomFieldResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -42,7 +61,7 @@ func (r *CustomFieldResource) Metadata(ctx context.Context, req resource.Metadat
 
 func (r *CustomFieldResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: `Twilio SendGrid's CustomFields feature allows you to receive notifications regarding your usage or program statistics from SendGrid at an email address you specify.`,
+		MarkdownDescription: `Twilio SendGrid's CustomFields feature allows you to store additional data, such as a favorite color, on the contacts of your contact database.`,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "The ID of CustomField",
@@ -56,7 +75,7 @@ func (r *CustomFieldResource) Schema(ctx context.Context, req resource.SchemaReq
 				},
 			},
 			"type": schema.StringAttribute{
-				MarkdownDescription: "The type of CustomField you want to create. Can be either usage_limit or stats_notification. Example: usage_limit",
+				MarkdownDescription: "The type of CustomField you want to create. Can be one of `text`, `number` or `date`. Example: text",
 				Required:            true,
 				Validators: []validator.String{
 					stringOneOf(
@@ -67,8 +86,16 @@ func (r *CustomFieldResource) Schema(ctx context.Context, req resource.SchemaReq
 				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
+					customFieldTypeChangeWarning{},
 				},
 			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Whether to adopt an existing CustomField with the same name into state instead of creating a new one. " +
+					"The existing field must have the configured type. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 		},
 	}
 }
@@ -78,18 +105,19 @@ func (r *CustomFieldResource) Configure(ctx context.Context, req resource.Config
 		return
 	}
 
-	client, ok := req.ProviderData.(*sendgrid.Client)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+	r.rateLimits = data.rateLimits
 }
 
 func (r *CustomFieldResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -99,6 +127,8 @@ func (r *CustomFieldResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "create", plan.ID)
+
 	err := validateCustomField(&plan)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -108,8 +138,26 @@ func (r *CustomFieldResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	if plan.AdoptExisting.ValueBool() {
+		existing, err := findAdoptableCustomField(ctx, sendgridClient{r.client}, plan.Name.ValueString(), plan.Type.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Creating CustomField",
+				fmt.Sprintf("Unable to adopt existing CustomField, got error: %s", sendgridErrorDetail(err)),
+			)
+			return
+		}
+		if existing != nil {
+			plan.ID = types.Int64Value(existing.ID)
+			plan.Name = types.StringValue(existing.Name)
+			plan.Type = types.StringValue(existing.Type)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+			return
+		}
+	}
+
 	// NOTE: Re-execute after the re-executable time has elapsed when a rate limit occurs
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
+	res, err := r.rateLimits.retryNonIdempotent(ctx, rateLimitGroupContactDB, func() (interface{}, error) {
 		return r.client.CreateCustomField(ctx, &sendgrid.InputCreateCustomField{
 			Name: plan.Name.ValueString(),
 			Type: plan.Type.ValueString(),
@@ -118,7 +166,7 @@ func (r *CustomFieldResource) Create(ctx context.Context, req resource.CreateReq
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Creating CustomField",
-			fmt.Sprintf("Unable to create CustomField, got error: %s", err),
+			fmt.Sprintf("Unable to create CustomField, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -133,9 +181,10 @@ func (r *CustomFieldResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	plan = CustomFieldResourceModel{
-		ID:   types.Int64Value(o.ID),
-		Name: types.StringValue(o.Name),
-		Type: types.StringValue(o.Type),
+		ID:            types.Int64Value(o.ID),
+		Name:          types.StringValue(o.Name),
+		Type:          types.StringValue(o.Type),
+		AdoptExisting: plan.AdoptExisting,
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -151,20 +200,36 @@ func (r *CustomFieldResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "read", state.ID)
+
 	id := state.ID.ValueInt64()
 
 	o, err := r.client.GetCustomField(ctx, id)
+	if isNotFoundError(err) {
+		resp.Diagnostics.AddWarning(
+			"Reading CustomField",
+			fmt.Sprintf("CustomField (id: %d) no longer exists in SendGrid and was removed from state, so it will be recreated.", id),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading CustomField",
-			fmt.Sprintf("Unable to read CustomField (id: %d), got error: %e", id, err),
+			fmt.Sprintf("Unable to read CustomField (id: %d), got error: %s", id, sendgridErrorDetail(err)),
 		)
 		return
 	}
 
+	// NOTE: The type of a custom field cannot be changed, so a different type means the field was replaced outside of Terraform.
+	resp.Diagnostics.Append(customFieldTypeDrift(id, state.Type, o.Type)...)
+
 	state.ID = types.Int64Value(id)
 	state.Name = types.StringValue(o.Name)
 	state.Type = types.StringValue(o.Type)
+	if state.AdoptExisting.IsNull() {
+		state.AdoptExisting = types.BoolValue(false)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -179,6 +244,22 @@ func (r *CustomFieldResource) Update(ctx context.Context, req resource.UpdateReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	ctx = withAPILogFields(ctx, "update", data.ID)
+
+	// NOTE: adopt_existing only affects creation, so toggling it is a state-only change.
+	if data.Name.Equal(state.Name) && data.Type.Equal(state.Type) {
+		data.ID = state.ID
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	// NOTE: SendGrid has no endpoint to update a custom field, and name and type require replacement,
+	//       so Update is not expected to be called for them. Fail rather than report changes that were never applied.
+	resp.Diagnostics.AddError(
+		"Updating CustomField",
+		fmt.Sprintf("Unable to update CustomField (id: %d), custom fields are immutable and must be replaced to change their name or type", state.ID.ValueInt64()),
+	)
 }
 
 func (r *CustomFieldResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -188,45 +269,74 @@ func (r *CustomFieldResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "delete", state.ID)
+
 	idint64 := state.ID.ValueInt64()
-	_, err := retryOnRateLimit(ctx, func() (interface{}, error) {
+	_, err := r.rateLimits.retry(ctx, rateLimitGroupContactDB, func() (interface{}, error) {
 		return nil, r.client.DeleteCustomField(ctx, idint64)
 	})
+	if usedBy, ok := customFieldInUse(err); ok {
+		detail := fmt.Sprintf("Unable to delete CustomField (id: %d) because it is in use", idint64)
+		if usedBy != "" {
+			detail += fmt.Sprintf(" by %s", usedBy)
+		}
+		resp.Diagnostics.AddError(
+			"Deleting CustomField",
+			fmt.Sprintf("%s. Remove the references to the field, such as segment conditions, then try again. Got error: %s", detail, sendgridErrorDetail(err)),
+		)
+		return
+	}
+	// The custom field is already gone, so there is nothing to delete.
+	if isNotFoundError(err) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Deleting alert",
-			fmt.Sprintf("Unable to delete alert (id: %d), got error: %s", idint64, err),
+			"Deleting CustomField",
+			fmt.Sprintf("Unable to delete CustomField (id: %d), got error: %s", idint64, sendgridErrorDetail(err)),
 		)
 		return
 	}
 }
 
 func (r *CustomFieldResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = withAPILogFields(ctx, "import", types.StringValue(req.ID))
+
 	var data CustomFieldResourceModel
 	id := req.ID
 	idInt64, err := strconv.ParseInt(id, 10, 64)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Importing CustomField",
-			fmt.Sprintf("Unable to read CustomField, got error: %s", err),
+			fmt.Sprintf("Unable to read CustomField, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idInt64)...)
 
-	o, err := r.client.GetCustomField(ctx, idInt64)
+	// NOTE: Fall back to listing custom fields when the id endpoint returns 404.
+	o, err := getCustomFieldByID(ctx, sendgridClient{r.client}, idInt64)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Importing CustomField",
-			fmt.Sprintf("Unable to read CustomField, got error: %s", err),
+			fmt.Sprintf("Unable to read CustomField, got error: %s", sendgridErrorDetail(err)),
+		)
+		return
+	}
+
+	if isReservedCustomField(o.Name) {
+		resp.Diagnostics.AddError(
+			"Importing CustomField",
+			fmt.Sprintf("CustomField (id: %d) is the reserved field %q and cannot be managed. Reserved fields: %s", idInt64, o.Name, strings.Join(reservedCustomFieldNames, ", ")),
 		)
 		return
 	}
 
 	data = CustomFieldResourceModel{
-		ID:   types.Int64Value(idInt64),
-		Name: types.StringValue(o.Name),
-		Type: types.StringValue(o.Type),
+		ID:            types.Int64Value(idInt64),
+		Name:          types.StringValue(o.Name),
+		Type:          types.StringValue(o.Type),
+		AdoptExisting: types.BoolValue(false),
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -234,6 +344,79 @@ func (r *CustomFieldResource) ImportState(ctx context.Context, req resource.Impo
 	}
 }
 
+func isReservedCustomField(name string) bool {
+	return slices.Contains(reservedCustomFieldNames, name)
+}
+
+// customFieldTypeDrift warns when the type read from SendGrid differs from the type in state.
+func customFieldTypeDrift(id int64, stateType types.String, remoteType string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if stateType.IsNull() || stateType.IsUnknown() || stateType.ValueString() == remoteType {
+		return diags
+	}
+	diags.AddAttributeWarning(
+		path.Root("type"),
+		"CustomField type drift",
+		fmt.Sprintf(
+			"CustomField (id: %d) has type %q in SendGrid but %q in state. "+
+				"Custom field types are immutable, so the field was likely deleted and recreated outside of Terraform. "+
+				"Terraform will plan to replace it to match the configuration.",
+			id, remoteType, stateType.ValueString(),
+		),
+	)
+	return diags
+}
+
+// customFieldInUsePattern matches the message of an error saying the custom field is still referenced,
+// capturing what it is referenced by if the message names it.
+var customFieldInUsePattern = regexp.MustCompile(`(?i)\b(?:is|are) (?:still )?(?:in use\b|(?:used|referenced) by (.+))`)
+
+// customFieldInUse reports whether err says the custom field is still referenced, e.g. by segments,
+// and returns what it is referenced by if the error names it.
+// Only the messages of the errors in the response body are matched, so that other errors that happen
+// to contain "in use", such as network errors, are not.
+func customFieldInUse(err error) (string, bool) {
+	for _, e := range sendgridFieldErrors(err) {
+		if m := customFieldInUsePattern.FindStringSubmatch(e.Message); m != nil {
+			return strings.Trim(m[1], " ."), true
+		}
+	}
+	return "", false
+}
+
+// customFieldTypeChangeWarning warns that changing the type of a CustomField, which replaces it,
+// leaves the new field empty when existing values cannot be converted to the new type.
+type customFieldTypeChangeWarning struct{}
+
+func (m customFieldTypeChangeWarning) Description(ctx context.Context) string {
+	return "Warns that changing the type replaces the field and drops values that do not convert to the new type."
+}
+
+func (m customFieldTypeChangeWarning) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m customFieldTypeChangeWarning) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.StateValue.IsNull() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	if req.StateValue.Equal(req.PlanValue) {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeWarning(
+		req.Path,
+		"CustomField type change",
+		fmt.Sprintf(
+			"Changing the type from %s to %s replaces the CustomField. "+
+				"Values stored on contacts that cannot be converted to %s (for example text to number) will be lost, "+
+				"so migrate the existing data before applying.",
+			req.StateValue.ValueString(), req.PlanValue.ValueString(), req.PlanValue.ValueString(),
+		),
+	)
+}
+
 func validateCustomField(_ *CustomFieldResourceModel) error {
 	return nil
 }
@@ -0,0 +1,522 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/i10416/sendgrid"
+)
+
+func TestAccCustomFieldResource(t *testing.T) {
+	resourceName := "sendgrid_custom_field.test"
+
+	name := fmt.Sprintf("test_acc_%s", acctest.RandStringFromCharSet(16, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCustomFieldDestroy,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCustomFieldResourceConfig(name, "text"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "type", "text"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestCustomFieldResourceWithMockTransport(t *testing.T) {
+	resourceName := "sendgrid_custom_field.test"
+
+	rt, fields := newMockCustomFieldTransport()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactoriesWithTransport(rt),
+		CheckDestroy: func(_ *terraform.State) error {
+			if fields.len() != 0 {
+				return errors.New("custom field still exists after destroy")
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testCustomFieldResourceMockConfig("favorite_color", "text"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name", "favorite_color"),
+					resource.TestCheckResourceAttr(resourceName, "type", "text"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Replace testing
+			{
+				Config: testCustomFieldResourceMockConfig("favorite_color", "number"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "2"),
+					resource.TestCheckResourceAttr(resourceName, "type", "number"),
+				),
+			},
+		},
+	})
+}
+
+func TestCustomFieldResourceUpdateIsRejected(t *testing.T) {
+	ctx := context.Background()
+	r := &CustomFieldResource{}
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	s := schemaResp.Schema
+
+	value := func(name, typ string) tftypes.Value {
+		return tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"id":             tftypes.NewValue(tftypes.Number, 1),
+			"name":           tftypes.NewValue(tftypes.String, name),
+			"type":           tftypes.NewValue(tftypes.String, typ),
+			"adopt_existing": tftypes.NewValue(tftypes.Bool, false),
+		})
+	}
+
+	resp := &fwresource.UpdateResponse{
+		State: tfsdk.State{Schema: s, Raw: value("favorite_color", "text")},
+	}
+	r.Update(ctx, fwresource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: s, Raw: value("favorite_colour", "text")},
+		State: tfsdk.State{Schema: s, Raw: value("favorite_color", "text")},
+	}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error when updating a custom field in place")
+	}
+}
+
+func TestCustomFieldResourceUpdateAdoptExisting(t *testing.T) {
+	ctx := context.Background()
+	r := &CustomFieldResource{}
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	s := schemaResp.Schema
+
+	value := func(adopt bool) tftypes.Value {
+		return tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"id":             tftypes.NewValue(tftypes.Number, 1),
+			"name":           tftypes.NewValue(tftypes.String, "favorite_color"),
+			"type":           tftypes.NewValue(tftypes.String, "text"),
+			"adopt_existing": tftypes.NewValue(tftypes.Bool, adopt),
+		})
+	}
+
+	// Toggling adopt_existing only changes state, so no client is needed.
+	resp := &fwresource.UpdateResponse{
+		State: tfsdk.State{Schema: s, Raw: value(true)},
+	}
+	r.Update(ctx, fwresource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: s, Raw: value(false)},
+		State: tfsdk.State{Schema: s, Raw: value(true)},
+	}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	var got CustomFieldResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	if got.AdoptExisting.ValueBool() || got.ID.ValueInt64() != 1 {
+		t.Errorf("got %+v, want adopt_existing false with id 1", got)
+	}
+}
+
+func TestCustomFieldResourceReadNotFound(t *testing.T) {
+	ctx := context.Background()
+	// The custom field was deleted outside of Terraform.
+	rt, _ := newMockCustomFieldTransport()
+	r := &CustomFieldResource{client: newMockClient(rt)}
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	s := schemaResp.Schema
+
+	state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+		"id":             tftypes.NewValue(tftypes.Number, 1),
+		"name":           tftypes.NewValue(tftypes.String, "favorite_color"),
+		"type":           tftypes.NewValue(tftypes.String, "text"),
+		"adopt_existing": tftypes.NewValue(tftypes.Bool, false),
+	})}
+	resp := &fwresource.ReadResponse{State: state}
+	r.Read(ctx, fwresource.ReadRequest{State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if resp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("got %v, want a single warning", resp.Diagnostics)
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Errorf("got state %v, want it to be removed", resp.State.Raw)
+	}
+}
+
+func TestCustomFieldResourceReadError(t *testing.T) {
+	ctx := context.Background()
+	rt := newMockTransport()
+	rt.Handle(http.MethodGet, "/v3/contactdb/custom_fields/1", func(_ *http.Request) (int, any) {
+		return http.StatusBadRequest, map[string]any{"errors": []map[string]any{{"field": nil, "message": "custom field id is invalid"}}}
+	})
+	r := &CustomFieldResource{client: newMockClient(rt)}
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	s := schemaResp.Schema
+
+	state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+		"id":             tftypes.NewValue(tftypes.Number, 1),
+		"name":           tftypes.NewValue(tftypes.String, "favorite_color"),
+		"type":           tftypes.NewValue(tftypes.String, "text"),
+		"adopt_existing": tftypes.NewValue(tftypes.Bool, false),
+	})}
+	resp := &fwresource.ReadResponse{State: state}
+	r.Read(ctx, fwresource.ReadRequest{State: state}, resp)
+
+	if resp.Diagnostics.ErrorsCount() != 1 {
+		t.Fatalf("got %v, want a single error", resp.Diagnostics)
+	}
+	detail := resp.Diagnostics.Errors()[0].Detail()
+	if !strings.Contains(detail, "custom field id is invalid") || strings.Contains(detail, "%!") {
+		t.Errorf("got detail %q, want it to contain the error message from SendGrid", detail)
+	}
+}
+
+func TestCustomFieldResourceImportStateRejectsReservedField(t *testing.T) {
+	ctx := context.Background()
+	rt, fields := newMockCustomFieldTransport()
+	// SendGrid lists the reserved field email with an id like any custom field.
+	fields.fields[1] = map[string]any{"id": 1, "name": "email", "type": "text"}
+	fields.fields[2] = map[string]any{"id": 2, "name": "favorite_color", "type": "text"}
+	fields.nextID = 3
+	r := &CustomFieldResource{client: newMockClient(rt)}
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	s := schemaResp.Schema
+
+	importState := func(id string) *fwresource.ImportStateResponse {
+		resp := &fwresource.ImportStateResponse{
+			State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)},
+		}
+		r.ImportState(ctx, fwresource.ImportStateRequest{ID: id}, resp)
+		return resp
+	}
+
+	resp := importState("1")
+	if resp.Diagnostics.ErrorsCount() != 1 {
+		t.Fatalf("got %v, want a single error", resp.Diagnostics)
+	}
+	if detail := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, `reserved field "email"`) {
+		t.Errorf("got detail %q, want it to name the reserved field", detail)
+	}
+
+	// A custom field that is not reserved is imported.
+	resp = importState("2")
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	var got CustomFieldResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	if got.ID.ValueInt64() != 2 || got.Name.ValueString() != "favorite_color" {
+		t.Errorf("got %+v, want favorite_color with id 2", got)
+	}
+}
+
+func TestIsReservedCustomField(t *testing.T) {
+	for _, name := range reservedCustomFieldNames {
+		if !isReservedCustomField(name) {
+			t.Errorf("expected %q to be reserved", name)
+		}
+	}
+
+	for _, name := range []string{"", "favorite_color", "Email", "first_name_2"} {
+		if isReservedCustomField(name) {
+			t.Errorf("expected %q not to be reserved", name)
+		}
+	}
+}
+
+func TestCustomFieldTypeDrift(t *testing.T) {
+	cases := []struct {
+		name        string
+		state       types.String
+		remote      string
+		wantWarning bool
+	}{
+		{name: "unchanged", state: types.StringValue("text"), remote: "text"},
+		{name: "null state", state: types.StringNull(), remote: "text"},
+		{name: "drift", state: types.StringValue("text"), remote: "number", wantWarning: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			diags := customFieldTypeDrift(1, c.state, c.remote)
+			if got := diags.WarningsCount() > 0; got != c.wantWarning {
+				t.Errorf("got warning %t, want %t: %v", got, c.wantWarning, diags)
+			}
+			if diags.HasError() {
+				t.Errorf("unexpected error: %v", diags)
+			}
+		})
+	}
+}
+
+func TestCustomFieldInUse(t *testing.T) {
+	cases := []struct {
+		name       string
+		status     int
+		body       any
+		wantUsedBy string
+		wantOk     bool
+	}{
+		{
+			name:   "in use",
+			status: http.StatusBadRequest,
+			body:   map[string]any{"errors": []map[string]any{{"field": nil, "message": "Custom field is in use."}}},
+			wantOk: true,
+		},
+		{
+			name:       "used by a segment",
+			status:     http.StatusBadRequest,
+			body:       map[string]any{"errors": []map[string]any{{"field": nil, "message": "Field is used by segment 'VIP customers'"}}},
+			wantUsedBy: "segment 'VIP customers'",
+			wantOk:     true,
+		},
+		{
+			name:       "referenced by segments",
+			status:     http.StatusBadRequest,
+			body:       map[string]any{"errors": []map[string]any{{"field": "id", "message": "custom field is still referenced by 2 segments."}}},
+			wantUsedBy: "2 segments",
+			wantOk:     true,
+		},
+		// "in use" is only matched as the state of the field, not as part of other words.
+		{
+			name:   "in user",
+			status: http.StatusBadRequest,
+			body:   map[string]any{"errors": []map[string]any{{"field": "id", "message": "custom field is not found in user account"}}},
+		},
+		{name: "no body", status: http.StatusInternalServerError},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rt := newMockTransport()
+			rt.Handle(http.MethodDelete, "/v3/contactdb/custom_fields/1", func(_ *http.Request) (int, any) {
+				return c.status, c.body
+			})
+			err := newMockClient(rt).DeleteCustomField(context.Background(), 1)
+			if err == nil {
+				t.Fatal("got no error, want the error response")
+			}
+
+			usedBy, ok := customFieldInUse(err)
+			if usedBy != c.wantUsedBy || ok != c.wantOk {
+				t.Errorf("customFieldInUse(%v) = (%q, %t), want (%q, %t)", err, usedBy, ok, c.wantUsedBy, c.wantOk)
+			}
+		})
+	}
+
+	// Errors that do not come from a response body are never a field in use.
+	for _, err := range []error{nil, errors.New("dial tcp 127.0.0.1:443: bind: address already in use")} {
+		if usedBy, ok := customFieldInUse(err); ok {
+			t.Errorf("customFieldInUse(%v) = (%q, %t), want false", err, usedBy, ok)
+		}
+	}
+}
+
+func TestCustomFieldTypeChangeWarning(t *testing.T) {
+	cases := []struct {
+		name        string
+		state       types.String
+		plan        types.String
+		wantWarning bool
+	}{
+		{name: "create", state: types.StringNull(), plan: types.StringValue("text")},
+		{name: "unchanged", state: types.StringValue("text"), plan: types.StringValue("text")},
+		{name: "unknown", state: types.StringValue("text"), plan: types.StringUnknown()},
+		{name: "text to number", state: types.StringValue("text"), plan: types.StringValue("number"), wantWarning: true},
+		{name: "date to text", state: types.StringValue("date"), plan: types.StringValue("text"), wantWarning: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			resp := &planmodifier.StringResponse{PlanValue: c.plan}
+			customFieldTypeChangeWarning{}.PlanModifyString(context.Background(), planmodifier.StringRequest{
+				Path:       path.Root("type"),
+				StateValue: c.state,
+				PlanValue:  c.plan,
+			}, resp)
+
+			if got := resp.Diagnostics.WarningsCount() > 0; got != c.wantWarning {
+				t.Errorf("got warning %t, want %t: %v", got, c.wantWarning, resp.Diagnostics)
+			}
+			if resp.Diagnostics.HasError() {
+				t.Errorf("unexpected error: %v", resp.Diagnostics)
+			}
+		})
+	}
+}
+
+// testAccCheckCustomFieldDestroy verifies that every destroyed custom field is gone from SendGrid.
+func testAccCheckCustomFieldDestroy(s *terraform.State) error {
+	client := sendgrid.New(os.Getenv("SENDGRID_API_KEY"))
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_custom_field" {
+			continue
+		}
+
+		id, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+		_, err = client.GetCustomField(context.Background(), id)
+		if err == nil {
+			return fmt.Errorf("custom field (id: %d) still exists", id)
+		}
+		if !isNotFoundError(err) {
+			return fmt.Errorf("unable to get custom field (id: %d), got error: %s", id, err)
+		}
+	}
+	return nil
+}
+
+func testAccCustomFieldResourceConfig(name, typ string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_custom_field" "test" {
+	name = "%[1]s"
+	type = "%[2]s"
+}
+`, name, typ)
+}
+
+// mockCustomFields is the in-memory store behind the mock custom field endpoints.
+type mockCustomFields struct {
+	mu     sync.Mutex
+	nextID int64
+	fields map[int64]map[string]any
+}
+
+func (f *mockCustomFields) len() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.fields)
+}
+
+// newMockCustomFieldTransport returns a mock transport serving the custom field endpoints
+// for the ids 1 to 3, and the store it serves them from.
+func newMockCustomFieldTransport() (*mockTransport, *mockCustomFields) {
+	rt := newMockTransport()
+	fields := &mockCustomFields{nextID: 1, fields: map[int64]map[string]any{}}
+
+	rt.Handle(http.MethodPost, "/v3/contactdb/custom_fields", func(req *http.Request) (int, any) {
+		var in map[string]any
+		if err := json.NewDecoder(req.Body).Decode(&in); err != nil {
+			return http.StatusBadRequest, nil
+		}
+
+		fields.mu.Lock()
+		defer fields.mu.Unlock()
+		f := map[string]any{"id": fields.nextID, "name": in["name"], "type": in["type"]}
+		fields.fields[fields.nextID] = f
+		fields.nextID++
+		return http.StatusCreated, f
+	})
+
+	rt.Handle(http.MethodGet, "/v3/contactdb/custom_fields", func(_ *http.Request) (int, any) {
+		fields.mu.Lock()
+		defer fields.mu.Unlock()
+		list := []map[string]any{}
+		for id := int64(1); id < fields.nextID; id++ {
+			if f, ok := fields.fields[id]; ok {
+				list = append(list, f)
+			}
+		}
+		return http.StatusOK, map[string]any{"custom_fields": list}
+	})
+
+	for id := int64(1); id <= 3; id++ {
+		p := fmt.Sprintf("/v3/contactdb/custom_fields/%d", id)
+		rt.Handle(http.MethodGet, p, func(_ *http.Request) (int, any) {
+			fields.mu.Lock()
+			defer fields.mu.Unlock()
+			f, ok := fields.fields[id]
+			if !ok {
+				return http.StatusNotFound, nil
+			}
+			return http.StatusOK, f
+		})
+		rt.Handle(http.MethodDelete, p, func(_ *http.Request) (int, any) {
+			fields.mu.Lock()
+			defer fields.mu.Unlock()
+			if _, ok := fields.fields[id]; !ok {
+				return http.StatusNotFound, nil
+			}
+			delete(fields.fields, id)
+			return http.StatusAccepted, nil
+		})
+	}
+
+	return rt, fields
+}
+
+func testCustomFieldResourceMockConfig(name, typ string) string {
+	return fmt.Sprintf(`
+provider "sendgrid" {
+	api_key = "SG.test"
+}
+
+resource "sendgrid_custom_field" "test" {
+	name = "%[1]s"
+	type = "%[2]s"
+}
+`, name, typ)
+}
@@ -24,7 +24,8 @@ func newEnforceTLSDataSource() datasource.DataSource {
 }
 
 type enforceTLSDataSource struct {
-	client *sendgrid.Client
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
 }
 
 type enforceTLSDataSourceModel struct {
@@ -43,16 +44,17 @@ func (d *enforceTLSDataSource) Configure(ctx context.Context, req datasource.Con
 		return
 	}
 
-	client, ok := req.ProviderData.(*sendgrid.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = data.client
+	d.rateLimits = data.rateLimits
 }
 
 func (d *enforceTLSDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
@@ -92,7 +94,7 @@ func (d *enforceTLSDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading enforceTLS",
-			fmt.Sprintf("Unable to get enforce TLS, err: %s", err.Error()),
+			fmt.Sprintf("Unable to get enforce TLS, err: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
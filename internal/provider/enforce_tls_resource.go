@@ -23,7 +23,8 @@ func newEnforceTLSResource() resource.Resource {
 }
 
 type enforceTLSResource struct {
-	client *sendgrid.Client
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
 }
 
 type enforceTLSResourceModel struct {
@@ -69,18 +70,19 @@ func (r *enforceTLSResource) Configure(ctx context.Context, req resource.Configu
 		return
 	}
 
-	client, ok := req.ProviderData.(*sendgrid.Client)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+	r.rateLimits = data.rateLimits
 }
 
 func (r *enforceTLSResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -90,6 +92,8 @@ func (r *enforceTLSResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "create", nil)
+
 	input := &sendgrid.InputUpdateEnforceTLS{}
 	if !plan.RequireTLS.IsNull() {
 		input.RequireTLS = plan.RequireTLS.ValueBool()
@@ -105,7 +109,7 @@ func (r *enforceTLSResource) Create(ctx context.Context, req resource.CreateRequ
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Creating enforceTLS",
-			fmt.Sprintf("Unable to update enforceTLS, got error: %s", err),
+			fmt.Sprintf("Unable to update enforceTLS, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -128,11 +132,13 @@ func (r *enforceTLSResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "read", nil)
+
 	o, err := r.client.GetEnforceTLS(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading enforceTLS",
-			fmt.Sprintf("Unable to read enforceTLS, got error: %s", err),
+			fmt.Sprintf("Unable to read enforceTLS, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -156,6 +162,8 @@ func (r *enforceTLSResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "update", nil)
+
 	input := &sendgrid.InputUpdateEnforceTLS{}
 	if !data.RequireTLS.IsNull() && data.RequireTLS.ValueBool() != state.RequireTLS.ValueBool() {
 		input.RequireTLS = data.RequireTLS.ValueBool()
@@ -171,7 +179,7 @@ func (r *enforceTLSResource) Update(ctx context.Context, req resource.UpdateRequ
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Updating enforceTLS",
-			fmt.Sprintf("Unable to update enforceTLS, got error: %s", err),
+			fmt.Sprintf("Unable to update enforceTLS, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -193,16 +201,25 @@ func (r *enforceTLSResource) Delete(ctx context.Context, req resource.DeleteRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	ctx = withAPILogFields(ctx, "delete", nil)
 }
 
 func (r *enforceTLSResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = withAPILogFields(ctx, "import", types.StringValue(req.ID))
+
 	var data enforceTLSResourceModel
 
+	resp.Diagnostics.Append(validateSingletonImportID("sendgrid_enforce_tls", req.ID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	o, err := r.client.GetEnforceTLS(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Importing enforceTLS",
-			fmt.Sprintf("Unable to read enforceTLS, got error: %s", err),
+			fmt.Sprintf("Unable to read enforceTLS, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
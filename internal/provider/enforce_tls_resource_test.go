@@ -33,6 +33,12 @@ func TestAccEnforceTLSResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateIdFunc: importEnforceTLSStateIdFunc(),
 			},
+			// ImportState testing with the singleton import ID
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: singletonImportID,
+			},
 		},
 	})
 }
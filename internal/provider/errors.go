@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/i10416/sendgrid"
+)
+
+// isNotFoundError reports whether err is a SendGrid API error with the 404 Not Found status.
+// Errors of other statuses are never treated as 404, even when their message reads like one.
+func isNotFoundError(err error) bool {
+	var sc interface{ HTTPStatusCode() int }
+	if errors.As(err, &sc) {
+		return sc.HTTPStatusCode() == http.StatusNotFound
+	}
+	return false
+}
+
+// notFoundStatusTransport keeps the status code of 404 Not Found responses for isNotFoundError.
+// The client only keeps the status code of an error response without a body, and returns the `error`
+// or `errors` of a body as a plain error. So the body of a 404 response is moved into its status text,
+// which the client includes in the error it returns along with the status code.
+type notFoundStatusTransport struct {
+	base http.RoundTripper
+}
+
+func (t notFoundStatusTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusNotFound {
+		return resp, err
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	var msg error
+	errorsResponse := sendgrid.ErrorsResponse{}
+	errorResponse := sendgrid.ErrorResponse{}
+	if json.Unmarshal(b, &errorsResponse) == nil && errorsResponse.Errs() != nil {
+		msg = errorsResponse.Errs()
+	} else if json.Unmarshal(b, &errorResponse) == nil && errorResponse.Err() != nil {
+		msg = errorResponse.Err()
+	}
+	if msg != nil {
+		resp.Status = fmt.Sprintf("%s: %s", resp.Status, msg)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(nil))
+	resp.ContentLength = 0
+	return resp, nil
+}
+
+// sendgridFieldError is a single entry of the `errors` array in a SendGrid error response.
+type sendgridFieldError struct {
+	Field   string
+	Message string
+}
+
+// sendgridErrorKey matches the keys the client writes when it flattens the `errors` array of a response
+// into "field: <field>, message: <message>, field: ...".
+var sendgridErrorKey = regexp.MustCompile(`(?:^|, )(field|message): `)
+
+// sendgridFieldErrors recovers the `errors` array of a SendGrid error response from err.
+// It returns nil when err does not come from such a response.
+func sendgridFieldErrors(err error) []sendgridFieldError {
+	if err == nil {
+		return nil
+	}
+	msg := err.Error()
+	if !strings.HasPrefix(msg, "field: ") && !strings.HasPrefix(msg, "message: ") {
+		return nil
+	}
+
+	var (
+		errs    []sendgridFieldError
+		current sendgridFieldError
+	)
+	keys := sendgridErrorKey.FindAllStringSubmatchIndex(msg, -1)
+	for i, k := range keys {
+		end := len(msg)
+		if i+1 < len(keys) {
+			end = keys[i+1][0]
+		}
+		value := msg[k[1]:end]
+
+		if msg[k[2]:k[3]] == "field" {
+			current.Field = value
+			continue
+		}
+		current.Message = value
+		errs = append(errs, current)
+		current = sendgridFieldError{}
+	}
+	return errs
+}
+
+// sendgridErrorDetail renders err for a diagnostic detail. Field errors returned by SendGrid are listed
+// one per line with the field they refer to, instead of the single line the client joins them into.
+func sendgridErrorDetail(err error) string {
+	errs := sendgridFieldErrors(err)
+	if len(errs) == 0 {
+		if err == nil {
+			return ""
+		}
+		return err.Error()
+	}
+
+	lines := make([]string, 0, len(errs))
+	for _, e := range errs {
+		if e.Field == "" {
+			lines = append(lines, e.Message)
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("field %q: %s", e.Field, e.Message))
+	}
+	if len(lines) == 1 {
+		return lines[0]
+	}
+	return "\n  - " + strings.Join(lines, "\n  - ")
+}
@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+type statusCodeError struct {
+	code int
+}
+
+func (e statusCodeError) Error() string {
+	return fmt.Sprintf("%d %s", e.code, http.StatusText(e.code))
+}
+
+func (e statusCodeError) HTTPStatusCode() int {
+	return e.code
+}
+
+func TestIsNotFoundError(t *testing.T) {
+	cases := []struct {
+		name   string
+		status int
+		body   any
+		want   bool
+	}{
+		{name: "errors body", status: http.StatusNotFound, body: map[string]any{
+			"errors": []map[string]any{{"field": nil, "message": "resource not found"}},
+		}, want: true},
+		{name: "errors body with field", status: http.StatusNotFound, body: map[string]any{
+			"errors": []map[string]any{{"field": "id", "message": "custom field ID does not exist"}},
+		}, want: true},
+		{name: "error body", status: http.StatusNotFound, body: map[string]any{"error": "not found"}, want: true},
+		{name: "no body", status: http.StatusNotFound, want: true},
+		{name: "bad request", status: http.StatusBadRequest, body: map[string]any{
+			"errors": []map[string]any{{"field": "name", "message": "invalid name"}},
+		}},
+		{name: "bad request reading like a 404", status: http.StatusBadRequest, body: map[string]any{
+			"errors": []map[string]any{{"field": "custom_field_id", "message": "custom field does not exist"}},
+		}},
+		{name: "bad request with not found error", status: http.StatusBadRequest, body: map[string]any{"error": "list not found"}},
+		{name: "server error without body", status: http.StatusInternalServerError},
+		{name: "ok", status: http.StatusOK, body: map[string]any{"id": 1, "name": "favorite_color", "type": "text"}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rt := newMockTransport()
+			rt.Handle(http.MethodGet, "/v3/contactdb/custom_fields/1", func(_ *http.Request) (int, any) {
+				return c.status, c.body
+			})
+
+			_, err := newMockClient(rt).GetCustomField(context.Background(), 1)
+			if got := isNotFoundError(err); got != c.want {
+				t.Errorf("isNotFoundError(%v) = %t, want %t", err, got, c.want)
+			}
+			if got := isNotFoundError(fmt.Errorf("wrapped: %w", err)); err != nil && got != c.want {
+				t.Errorf("isNotFoundError of wrapped %v = %t, want %t", err, got, c.want)
+			}
+		})
+	}
+
+	if isNotFoundError(errors.New("sendgrid rate limit exceeded, retry after 1s")) {
+		t.Error("a rate limit error is not a 404")
+	}
+	if isNotFoundError(errors.New("message: resource not found")) {
+		t.Error("an error without a status code is not a 404")
+	}
+}
+
+func TestNotFoundStatusTransportKeepsMessage(t *testing.T) {
+	rt := newMockTransport()
+	rt.Handle(http.MethodGet, "/v3/contactdb/custom_fields/1", func(_ *http.Request) (int, any) {
+		return http.StatusNotFound, map[string]any{"errors": []map[string]any{{"field": "id", "message": "custom field ID does not exist"}}}
+	})
+
+	_, err := newMockClient(rt).GetCustomField(context.Background(), 1)
+	if !isNotFoundError(err) {
+		t.Fatalf("got %v, want a 404 error", err)
+	}
+	if detail := sendgridErrorDetail(err); !strings.Contains(detail, "custom field ID does not exist") {
+		t.Errorf("got detail %q, want it to contain the message from SendGrid", detail)
+	}
+}
+
+func TestSendgridFieldErrors(t *testing.T) {
+	cases := []struct {
+		err  error
+		want []sendgridFieldError
+	}{
+		{err: nil},
+		{err: statusCodeError{code: http.StatusBadRequest}},
+		{err: errors.New("sendgrid rate limit exceeded, retry after 1s")},
+		{
+			err:  errors.New("message: access forbidden"),
+			want: []sendgridFieldError{{Message: "access forbidden"}},
+		},
+		{
+			err: errors.New("field: name, message: name is required, field: type, message: type must be one of text, number, date"),
+			want: []sendgridFieldError{
+				{Field: "name", Message: "name is required"},
+				{Field: "type", Message: "type must be one of text, number, date"},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		if got := sendgridFieldErrors(c.err); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("sendgridFieldErrors(%v) = %+v, want %+v", c.err, got, c.want)
+		}
+	}
+}
+
+func TestSendgridErrorDetail(t *testing.T) {
+	cases := []struct {
+		err  error
+		want string
+	}{
+		{err: nil, want: ""},
+		{err: errors.New("boom"), want: "boom"},
+		{err: errors.New("message: access forbidden"), want: "access forbidden"},
+		{err: errors.New("field: name, message: name is required"), want: `field "name": name is required`},
+		{
+			err:  errors.New("field: name, message: name is required, message: invalid request"),
+			want: "\n  - field \"name\": name is required\n  - invalid request",
+		},
+	}
+
+	for _, c := range cases {
+		if got := sendgridErrorDetail(c.err); got != c.want {
+			t.Errorf("sendgridErrorDetail(%v) = %q, want %q", c.err, got, c.want)
+		}
+	}
+}
+
+func TestSendgridErrorDetailInDiagnostics(t *testing.T) {
+	rt := newMockTransport()
+	rt.Handle(http.MethodPost, "/v3/contactdb/custom_fields", func(_ *http.Request) (int, any) {
+		return http.StatusBadRequest, map[string]any{
+			"errors": []map[string]any{
+				{"field": "name", "message": "a field with this name already exists"},
+			},
+		}
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactoriesWithTransport(rt),
+		Steps: []resource.TestStep{
+			{
+				Config:      testCustomFieldResourceMockConfig("favorite_color", "text"),
+				ExpectError: regexp.MustCompile(`field "name": a field with this name already exists`),
+			},
+		},
+	})
+}
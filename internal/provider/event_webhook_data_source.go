@@ -24,7 +24,8 @@ func newEventWebhookDataSource() datasource.DataSource {
 }
 
 type eventWebhookDataSource struct {
-	client *sendgrid.Client
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
 }
 
 type eventWebhookDataSourceModel struct {
@@ -60,16 +61,17 @@ func (d *eventWebhookDataSource) Configure(ctx context.Context, req datasource.C
 		return
 	}
 
-	client, ok := req.ProviderData.(*sendgrid.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = data.client
+	d.rateLimits = data.rateLimits
 }
 
 func (d *eventWebhookDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
@@ -179,7 +181,7 @@ func (d *eventWebhookDataSource) Read(ctx context.Context, req datasource.ReadRe
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading event webhook",
-			fmt.Sprintf("Unable to get event webhook by id: %s, err: %s", id, err.Error()),
+			fmt.Sprintf("Unable to get event webhook by id: %s, err: %s", id, sendgridErrorDetail(err)),
 		)
 		return
 	}
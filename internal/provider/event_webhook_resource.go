@@ -23,7 +23,8 @@ func newEventWebhookResource() resource.Resource {
 }
 
 type eventWebhookResource struct {
-	client *sendgrid.Client
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
 }
 
 type eventWebhookResourceModel struct {
@@ -180,18 +181,19 @@ func (r *eventWebhookResource) Configure(ctx context.Context, req resource.Confi
 		return
 	}
 
-	client, ok := req.ProviderData.(*sendgrid.Client)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+	r.rateLimits = data.rateLimits
 }
 
 func (r *eventWebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -201,6 +203,8 @@ func (r *eventWebhookResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "create", plan.ID)
+
 	input := &sendgrid.InputCreateEventWebhook{
 		Enabled:          plan.Enabled.ValueBool(),
 		URL:              plan.URL.ValueString(),
@@ -227,13 +231,13 @@ func (r *eventWebhookResource) Create(ctx context.Context, req resource.CreateRe
 		input.OAuthTokenURL = plan.OAuthTokenURL.ValueString()
 	}
 
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
+	res, err := r.rateLimits.retryNonIdempotent(ctx, rateLimitGroupEventWebhook, func() (interface{}, error) {
 		return r.client.CreateEventWebhook(context.TODO(), input)
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Creating event webhook",
-			fmt.Sprintf("Unable to create event webhook, got error: %s", err),
+			fmt.Sprintf("Unable to create event webhook, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -252,7 +256,7 @@ func (r *eventWebhookResource) Create(ctx context.Context, req resource.CreateRe
 
 	// Handle signature verification if enabled
 	if signed {
-		res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
+		res, err := r.rateLimits.retry(ctx, rateLimitGroupEventWebhook, func() (interface{}, error) {
 			return r.client.ToggleSignatureVerification(ctx, o.ID, &sendgrid.InputToggleSignatureVerification{
 				Enabled: true,
 			})
@@ -260,7 +264,7 @@ func (r *eventWebhookResource) Create(ctx context.Context, req resource.CreateRe
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Enabling signature verification",
-				fmt.Sprintf("Unable to enable signature verification, got error: %s", err),
+				fmt.Sprintf("Unable to enable signature verification, got error: %s", sendgridErrorDetail(err)),
 			)
 			return
 		}
@@ -278,25 +282,26 @@ func (r *eventWebhookResource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	plan = eventWebhookResourceModel{
-		ID:               types.StringValue(o.ID),
-		Enabled:          types.BoolValue(o.Enabled),
-		URL:              types.StringValue(o.URL),
-		GroupResubscribe: types.BoolValue(o.GroupResubscribe),
-		Delivered:        types.BoolValue(o.Delivered),
-		GroupUnsubscribe: types.BoolValue(o.GroupUnsubscribe),
-		SpamReport:       types.BoolValue(o.SpamReport),
-		Bounce:           types.BoolValue(o.Bounce),
-		Deferred:         types.BoolValue(o.Deferred),
-		Unsubscribe:      types.BoolValue(o.Unsubscribe),
-		Processed:        types.BoolValue(o.Processed),
-		Open:             types.BoolValue(o.Open),
-		Click:            types.BoolValue(o.Click),
-		Dropped:          types.BoolValue(o.Dropped),
-		FriendlyName:     types.StringValue(o.FriendlyName),
-		OAuthClientID:    types.StringValue(o.OAuthClientID),
-		OAuthTokenURL:    types.StringValue(o.OAuthTokenURL),
-		Signed:           types.BoolValue(signed),
-		PublicKey:        types.StringValue(publicKey),
+		ID:                types.StringValue(o.ID),
+		Enabled:           types.BoolValue(o.Enabled),
+		URL:               types.StringValue(o.URL),
+		GroupResubscribe:  types.BoolValue(o.GroupResubscribe),
+		Delivered:         types.BoolValue(o.Delivered),
+		GroupUnsubscribe:  types.BoolValue(o.GroupUnsubscribe),
+		SpamReport:        types.BoolValue(o.SpamReport),
+		Bounce:            types.BoolValue(o.Bounce),
+		Deferred:          types.BoolValue(o.Deferred),
+		Unsubscribe:       types.BoolValue(o.Unsubscribe),
+		Processed:         types.BoolValue(o.Processed),
+		Open:              types.BoolValue(o.Open),
+		Click:             types.BoolValue(o.Click),
+		Dropped:           types.BoolValue(o.Dropped),
+		FriendlyName:      types.StringValue(o.FriendlyName),
+		OAuthClientID:     types.StringValue(o.OAuthClientID),
+		OAuthClientSecret: eventWebhookOAuthClientSecret(plan.OAuthClientSecret),
+		OAuthTokenURL:     types.StringValue(o.OAuthTokenURL),
+		Signed:            types.BoolValue(signed),
+		PublicKey:         types.StringValue(publicKey),
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -311,12 +316,14 @@ func (r *eventWebhookResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "read", state.ID)
+
 	id := state.ID.ValueString()
 	o, err := r.client.GetEventWebhook(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading event webhook",
-			fmt.Sprintf("Unable to read event webhook, got error: %s", err),
+			fmt.Sprintf("Unable to read event webhook, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -338,9 +345,11 @@ func (r *eventWebhookResource) Read(ctx context.Context, req resource.ReadReques
 		Dropped:          types.BoolValue(o.Dropped),
 		FriendlyName:     types.StringValue(o.FriendlyName),
 		OAuthClientID:    types.StringValue(o.OAuthClientID),
-		OAuthTokenURL:    types.StringValue(o.OAuthTokenURL),
-		Signed:           types.BoolValue(o.PublicKey != ""),
-		PublicKey:        types.StringValue(o.PublicKey),
+		// SendGrid never returns the OAuth client secret, so the value in the tfstate is kept.
+		OAuthClientSecret: state.OAuthClientSecret,
+		OAuthTokenURL:     types.StringValue(o.OAuthTokenURL),
+		Signed:            types.BoolValue(o.PublicKey != ""),
+		PublicKey:         types.StringValue(o.PublicKey),
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -356,48 +365,77 @@ func (r *eventWebhookResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
-	input := &sendgrid.InputUpdateEventWebhook{
-		Enabled:          plan.Enabled.ValueBool(),
-		URL:              plan.URL.ValueString(),
-		GroupResubscribe: plan.GroupResubscribe.ValueBool(),
-		Delivered:        plan.Delivered.ValueBool(),
-		GroupUnsubscribe: plan.GroupUnsubscribe.ValueBool(),
-		SpamReport:       plan.SpamReport.ValueBool(),
-		Bounce:           plan.Bounce.ValueBool(),
-		Deferred:         plan.Deferred.ValueBool(),
-		Unsubscribe:      plan.Unsubscribe.ValueBool(),
-		Processed:        plan.Processed.ValueBool(),
-		Open:             plan.Open.ValueBool(),
-		Click:            plan.Click.ValueBool(),
-		Dropped:          plan.Dropped.ValueBool(),
-		FriendlyName:     plan.FriendlyName.ValueString(),
-	}
-	if !plan.OAuthClientID.IsNull() {
-		input.OAuthClientID = plan.OAuthClientID.ValueString()
-	}
-	if !plan.OAuthClientSecret.IsNull() {
-		input.OAuthClientSecret = plan.OAuthClientSecret.ValueString()
-	}
-	if !plan.OAuthTokenURL.IsNull() {
-		input.OAuthTokenURL = plan.OAuthTokenURL.ValueString()
-	}
+	ctx = withAPILogFields(ctx, "update", plan.ID)
 
 	id := state.ID.ValueString()
-	o, err := r.client.UpdateEventWebhook(ctx, id, input)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Updating event webhook",
-			fmt.Sprintf("Unable to update event webhook, got error: %s", err),
-		)
-		return
-	}
+	data := state
+
+	// NOTE: The update endpoint takes the whole settings object, so it is only called when one of the settings changed.
+	//       Toggling signature verification alone does not re-send the settings.
+	if eventWebhookSettingsChanged(plan, state) {
+		input := &sendgrid.InputUpdateEventWebhook{
+			Enabled:          plan.Enabled.ValueBool(),
+			URL:              plan.URL.ValueString(),
+			GroupResubscribe: plan.GroupResubscribe.ValueBool(),
+			Delivered:        plan.Delivered.ValueBool(),
+			GroupUnsubscribe: plan.GroupUnsubscribe.ValueBool(),
+			SpamReport:       plan.SpamReport.ValueBool(),
+			Bounce:           plan.Bounce.ValueBool(),
+			Deferred:         plan.Deferred.ValueBool(),
+			Unsubscribe:      plan.Unsubscribe.ValueBool(),
+			Processed:        plan.Processed.ValueBool(),
+			Open:             plan.Open.ValueBool(),
+			Click:            plan.Click.ValueBool(),
+			Dropped:          plan.Dropped.ValueBool(),
+			FriendlyName:     plan.FriendlyName.ValueString(),
+		}
+		if !plan.OAuthClientID.IsNull() {
+			input.OAuthClientID = plan.OAuthClientID.ValueString()
+		}
+		if !plan.OAuthClientSecret.IsNull() {
+			input.OAuthClientSecret = plan.OAuthClientSecret.ValueString()
+		}
+		if !plan.OAuthTokenURL.IsNull() {
+			input.OAuthTokenURL = plan.OAuthTokenURL.ValueString()
+		}
 
-	var publicKey string
-	signed := plan.Signed.ValueBool()
+		o, err := r.client.UpdateEventWebhook(ctx, id, input)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Updating event webhook",
+				fmt.Sprintf("Unable to update event webhook, got error: %s", sendgridErrorDetail(err)),
+			)
+			return
+		}
+
+		data = eventWebhookResourceModel{
+			ID:               types.StringValue(o.ID),
+			Enabled:          types.BoolValue(o.Enabled),
+			URL:              types.StringValue(o.URL),
+			GroupResubscribe: types.BoolValue(o.GroupResubscribe),
+			Delivered:        types.BoolValue(o.Delivered),
+			GroupUnsubscribe: types.BoolValue(o.GroupUnsubscribe),
+			SpamReport:       types.BoolValue(o.SpamReport),
+			Bounce:           types.BoolValue(o.Bounce),
+			Deferred:         types.BoolValue(o.Deferred),
+			Unsubscribe:      types.BoolValue(o.Unsubscribe),
+			Processed:        types.BoolValue(o.Processed),
+			Open:             types.BoolValue(o.Open),
+			Click:            types.BoolValue(o.Click),
+			Dropped:          types.BoolValue(o.Dropped),
+			FriendlyName:     types.StringValue(o.FriendlyName),
+			OAuthClientID:    types.StringValue(o.OAuthClientID),
+			OAuthTokenURL:    types.StringValue(o.OAuthTokenURL),
+			Signed:           state.Signed,
+			PublicKey:        state.PublicKey,
+		}
+	}
+	data.OAuthClientSecret = eventWebhookOAuthClientSecret(plan.OAuthClientSecret)
 
 	// Handle signature verification separately if it has changed
 	if !plan.Signed.Equal(state.Signed) {
-		res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
+		signed := plan.Signed.ValueBool()
+		res, err := r.rateLimits.retry(ctx, rateLimitGroupEventWebhook, func() (interface{}, error) {
 			return r.client.ToggleSignatureVerification(ctx, id, &sendgrid.InputToggleSignatureVerification{
 				Enabled: signed,
 			})
@@ -405,7 +443,7 @@ func (r *eventWebhookResource) Update(ctx context.Context, req resource.UpdateRe
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Updating event webhook signature verification",
-				fmt.Sprintf("Unable to update signature verification, got error: %s", err),
+				fmt.Sprintf("Unable to update signature verification, got error: %s", sendgridErrorDetail(err)),
 			)
 			return
 		}
@@ -419,30 +457,10 @@ func (r *eventWebhookResource) Update(ctx context.Context, req resource.UpdateRe
 			return
 		}
 
-		publicKey = o.PublicKey
+		data.Signed = types.BoolValue(signed)
+		data.PublicKey = types.StringValue(o.PublicKey)
 	}
 
-	data := eventWebhookResourceModel{
-		ID:               types.StringValue(o.ID),
-		Enabled:          types.BoolValue(o.Enabled),
-		URL:              types.StringValue(o.URL),
-		GroupResubscribe: types.BoolValue(o.GroupResubscribe),
-		Delivered:        types.BoolValue(o.Delivered),
-		GroupUnsubscribe: types.BoolValue(o.GroupUnsubscribe),
-		SpamReport:       types.BoolValue(o.SpamReport),
-		Bounce:           types.BoolValue(o.Bounce),
-		Deferred:         types.BoolValue(o.Deferred),
-		Unsubscribe:      types.BoolValue(o.Unsubscribe),
-		Processed:        types.BoolValue(o.Processed),
-		Open:             types.BoolValue(o.Open),
-		Click:            types.BoolValue(o.Click),
-		Dropped:          types.BoolValue(o.Dropped),
-		FriendlyName:     types.StringValue(o.FriendlyName),
-		OAuthClientID:    types.StringValue(o.OAuthClientID),
-		OAuthTokenURL:    types.StringValue(o.OAuthTokenURL),
-		Signed:           types.BoolValue(signed),
-		PublicKey:        types.StringValue(publicKey),
-	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -456,26 +474,30 @@ func (r *eventWebhookResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "delete", data.ID)
+
 	id := data.ID.ValueString()
-	_, err := retryOnRateLimit(ctx, func() (interface{}, error) {
+	_, err := r.rateLimits.retry(ctx, rateLimitGroupEventWebhook, func() (interface{}, error) {
 		return nil, r.client.DeleteEventWebhook(ctx, id)
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Deleting event webhook",
-			fmt.Sprintf("Unable to delete event webhook, got error: %s", err),
+			fmt.Sprintf("Unable to delete event webhook, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
 }
 
 func (r *eventWebhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = withAPILogFields(ctx, "import", types.StringValue(req.ID))
+
 	id := req.ID
 	o, err := r.client.GetEventWebhook(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Importing event webhook",
-			fmt.Sprintf("Unable to read event webhook, got error: %s", err),
+			fmt.Sprintf("Unable to read event webhook, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -506,3 +528,33 @@ func (r *eventWebhookResource) ImportState(ctx context.Context, req resource.Imp
 		return
 	}
 }
+
+// eventWebhookSettingsChanged reports whether any setting sent through the update endpoint differs between plan and state.
+func eventWebhookSettingsChanged(plan, state eventWebhookResourceModel) bool {
+	return !plan.Enabled.Equal(state.Enabled) ||
+		!plan.URL.Equal(state.URL) ||
+		!plan.GroupResubscribe.Equal(state.GroupResubscribe) ||
+		!plan.Delivered.Equal(state.Delivered) ||
+		!plan.GroupUnsubscribe.Equal(state.GroupUnsubscribe) ||
+		!plan.SpamReport.Equal(state.SpamReport) ||
+		!plan.Bounce.Equal(state.Bounce) ||
+		!plan.Deferred.Equal(state.Deferred) ||
+		!plan.Unsubscribe.Equal(state.Unsubscribe) ||
+		!plan.Processed.Equal(state.Processed) ||
+		!plan.Open.Equal(state.Open) ||
+		!plan.Click.Equal(state.Click) ||
+		!plan.Dropped.Equal(state.Dropped) ||
+		!plan.FriendlyName.Equal(state.FriendlyName) ||
+		!plan.OAuthClientID.Equal(state.OAuthClientID) ||
+		!plan.OAuthClientSecret.Equal(state.OAuthClientSecret) ||
+		!plan.OAuthTokenURL.Equal(state.OAuthTokenURL)
+}
+
+// eventWebhookOAuthClientSecret returns the OAuth client secret to store in the tfstate.
+// SendGrid never returns the secret, so the planned value is kept as is.
+func eventWebhookOAuthClientSecret(planned types.String) types.String {
+	if planned.IsUnknown() {
+		return types.StringNull()
+	}
+	return planned
+}
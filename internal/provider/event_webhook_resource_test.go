@@ -7,8 +7,10 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 func TestAccEventWebhookResource(t *testing.T) {
@@ -56,3 +58,177 @@ resource "sendgrid_event_webhook" "test" {
 }
 `, url, enabled)
 }
+
+func TestAccEventWebhookResourceToggleEvent(t *testing.T) {
+	resourceName := "sendgrid_event_webhook.test"
+
+	url := fmt.Sprintf("https://test-acc-%s.com", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccEventWebhookResourceEventConfig(url, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "click", "false"),
+					resource.TestCheckResourceAttr(resourceName, "delivered", "true"),
+				),
+			},
+			// Toggle a single event; the plan after apply must be empty
+			{
+				Config: testAccEventWebhookResourceEventConfig(url, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "click", "true"),
+					resource.TestCheckResourceAttr(resourceName, "delivered", "true"),
+				),
+			},
+			// Re-applying the same configuration must not plan any change
+			{
+				Config:   testAccEventWebhookResourceEventConfig(url, true),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccEventWebhookResourceMultiple(t *testing.T) {
+	first := fmt.Sprintf("https://test-acc-%s.com", acctest.RandString(16))
+	second := fmt.Sprintf("https://test-acc-%s.com", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create two webhooks with their own settings
+			{
+				Config: testAccEventWebhookResourceMultipleConfig(first, second, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.first", "url", first),
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.first", "signed", "true"),
+					resource.TestCheckResourceAttrSet("sendgrid_event_webhook.first", "public_key"),
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.second", "url", second),
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.second", "signed", "false"),
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.second", "click", "false"),
+				),
+			},
+			// Updating one webhook leaves the other untouched
+			{
+				Config: testAccEventWebhookResourceMultipleConfig(first, second, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("sendgrid_event_webhook.first", plancheck.ResourceActionNoop),
+						plancheck.ExpectResourceAction("sendgrid_event_webhook.second", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.first", "click", "false"),
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.second", "click", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEventWebhookResourceMultipleConfig(first, second string, click bool) string {
+	return fmt.Sprintf(`
+resource "sendgrid_event_webhook" "first" {
+  url           = "%[1]s"
+  friendly_name = "first"
+  signed        = true
+}
+
+resource "sendgrid_event_webhook" "second" {
+  url           = "%[2]s"
+  friendly_name = "second"
+  click         = %[3]t
+}
+`, first, second, click)
+}
+
+func TestEventWebhookSettingsChanged(t *testing.T) {
+	state := eventWebhookResourceModel{
+		ID:        types.StringValue("id"),
+		Enabled:   types.BoolValue(true),
+		URL:       types.StringValue("https://example.com"),
+		Click:     types.BoolValue(false),
+		Signed:    types.BoolValue(false),
+		PublicKey: types.StringValue(""),
+	}
+
+	if eventWebhookSettingsChanged(state, state) {
+		t.Error("expected no change for identical settings")
+	}
+
+	plan := state
+	plan.Click = types.BoolValue(true)
+	if !eventWebhookSettingsChanged(plan, state) {
+		t.Error("expected a change when an event flag is toggled")
+	}
+
+	plan = state
+	plan.Signed = types.BoolValue(true)
+	plan.PublicKey = types.StringUnknown()
+	if eventWebhookSettingsChanged(plan, state) {
+		t.Error("expected signature verification not to count as a settings change")
+	}
+}
+
+func testAccEventWebhookResourceEventConfig(url string, click bool) string {
+	return fmt.Sprintf(`
+resource "sendgrid_event_webhook" "test" {
+  url       = "%s"
+  delivered = true
+  click     = %t
+}
+`, url, click)
+}
+
+func TestAccEventWebhookResourceInPlaceUpdate(t *testing.T) {
+	resourceName := "sendgrid_event_webhook.test"
+
+	url := fmt.Sprintf("https://test-acc-%s.com", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventWebhookResourceToggleConfig(url, false, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "click", "false"),
+				),
+			},
+			// Toggling enabled and an event flag together must not recreate the webhook.
+			{
+				Config: testAccEventWebhookResourceToggleConfig(url, true, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "click", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccEventWebhookResourceToggleConfig(url string, enabled, click bool) string {
+	return fmt.Sprintf(`
+resource "sendgrid_event_webhook" "test" {
+  url     = "%s"
+  enabled = %t
+  click   = %t
+}
+`, url, enabled, click)
+}
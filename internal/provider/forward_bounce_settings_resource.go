@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/i10416/sendgrid"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &forwardBounceSettingsResource{}
+var _ resource.ResourceWithImportState = &forwardBounceSettingsResource{}
+
+var forwardBounceEmailValidator = stringvalidator.RegexMatches(
+	regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`),
+	"must be an email address",
+)
+
+func newForwardBounceSettingsResource() resource.Resource {
+	return &forwardBounceSettingsResource{}
+}
+
+type forwardBounceSettingsResource struct {
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
+}
+
+type forwardBounceSettingsResourceModel struct {
+	Enabled types.Bool   `tfsdk:"enabled"`
+	Email   types.String `tfsdk:"email"`
+}
+
+func (r *forwardBounceSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_forward_bounce_settings"
+}
+
+func (r *forwardBounceSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Manages the forward bounce mail setting, which forwards the bounce reports of the account to an email address.
+
+Destroying this resource disables the setting.
+
+For more detailed information, please see the [SendGrid documentation](https://www.twilio.com/docs/sendgrid/ui/account-and-settings/mail#forward-bounce).
+		`,
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Indicates if bounce reports are forwarded.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email address to forward bounce reports to. SendGrid forwards them to the email address of the account if omitted.",
+				Optional:            true,
+				Validators: []validator.String{
+					forwardBounceEmailValidator,
+				},
+			},
+		},
+	}
+}
+
+func (r *forwardBounceSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+	r.rateLimits = data.rateLimits
+}
+
+func (r *forwardBounceSettingsResource) singleton() singletonResource[forwardBounceSettingsResourceModel] {
+	client := sendgridClient{r.client}
+	update := func(ctx context.Context, input *forwardBounce) (forwardBounceSettingsResourceModel, error) {
+		// NOTE: Re-execute after the re-executable time has elapsed when a rate limit occurs
+		res, err := r.rateLimits.retry(ctx, rateLimitGroupMailSettings, func() (interface{}, error) {
+			return client.UpdateForwardBounce(ctx, input)
+		})
+		if err != nil {
+			return forwardBounceSettingsResourceModel{}, err
+		}
+		o, ok := res.(*forwardBounce)
+		if !ok {
+			return forwardBounceSettingsResourceModel{}, fmt.Errorf("failed to assert type *forwardBounce")
+		}
+		return forwardBounceSettingsModel(o), nil
+	}
+
+	return singletonResource[forwardBounceSettingsResourceModel]{
+		name:     "forward bounce settings",
+		typeName: "sendgrid_forward_bounce_settings",
+		get: func(ctx context.Context) (forwardBounceSettingsResourceModel, error) {
+			// NOTE: Re-execute after the re-executable time has elapsed when a rate limit occurs
+			res, err := r.rateLimits.retry(ctx, rateLimitGroupMailSettings, func() (interface{}, error) {
+				return client.GetForwardBounce(ctx)
+			})
+			if err != nil {
+				return forwardBounceSettingsResourceModel{}, err
+			}
+			o, ok := res.(*forwardBounce)
+			if !ok {
+				return forwardBounceSettingsResourceModel{}, fmt.Errorf("failed to assert type *forwardBounce")
+			}
+			return forwardBounceSettingsModel(o), nil
+		},
+		set: func(ctx context.Context, plan forwardBounceSettingsResourceModel) (forwardBounceSettingsResourceModel, error) {
+			return update(ctx, &forwardBounce{
+				Enabled: plan.Enabled.ValueBool(),
+				Email:   plan.Email.ValueString(),
+			})
+		},
+		disable: func(ctx context.Context) error {
+			_, err := update(ctx, &forwardBounce{Enabled: false})
+			return err
+		},
+	}
+}
+
+func (r *forwardBounceSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.singleton().create(ctx, req, resp)
+}
+
+func (r *forwardBounceSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.singleton().read(ctx, req, resp)
+}
+
+func (r *forwardBounceSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.singleton().update(ctx, req, resp)
+}
+
+func (r *forwardBounceSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.singleton().delete(ctx, req, resp)
+}
+
+func (r *forwardBounceSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.singleton().importState(ctx, req, resp)
+}
+
+// forwardBounceSettingsModel maps o to the resource model. SendGrid returns an empty email when none is set,
+// which is kept null so that an omitted email has no diff.
+func forwardBounceSettingsModel(o *forwardBounce) forwardBounceSettingsResourceModel {
+	email := types.StringNull()
+	if o.Email != "" {
+		email = types.StringValue(o.Email)
+	}
+	return forwardBounceSettingsResourceModel{
+		Enabled: types.BoolValue(o.Enabled),
+		Email:   email,
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestForwardBounceSettingsResourceWithMockTransport(t *testing.T) {
+	resourceName := "sendgrid_forward_bounce_settings.test"
+	rt, _ := newMockMailSettingTransport("forward_bounce", map[string]any{"enabled": false, "email": ""})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactoriesWithTransport(rt),
+		Steps: []resource.TestStep{
+			{
+				Config:      testForwardBounceSettingsResourceMockConfig(true, "not-an-email"),
+				ExpectError: regexp.MustCompile(`must be an email address`),
+			},
+			// Create and Read testing
+			{
+				Config: testForwardBounceSettingsResourceMockConfig(true, "bounces@example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "email", "bounces@example.com"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     singletonImportID,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestForwardBounceSettingsRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	rt, setting := newMockMailSettingTransport("forward_bounce", map[string]any{"enabled": false, "email": ""})
+	s := (&forwardBounceSettingsResource{client: newMockClient(rt)}).singleton()
+
+	// An unset email is read as null, so an omitted email has no diff.
+	got, err := s.get(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got.Enabled.ValueBool() || !got.Email.IsNull() {
+		t.Errorf("got %+v, want disabled without an email", got)
+	}
+
+	want := forwardBounceSettingsResourceModel{Enabled: types.BoolValue(true), Email: types.StringValue("bounces@example.com")}
+	got, err = s.set(ctx, want)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got != want {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+	if got, err = s.get(ctx); err != nil || got != want {
+		t.Errorf("got %+v, %v after reading it back, want %+v", got, err, want)
+	}
+
+	if err := s.disable(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if setting.get("enabled") != false {
+		t.Error("got forward bounce enabled after disable, want it disabled")
+	}
+}
+
+func TestForwardBounceEmailValidator(t *testing.T) {
+	cases := []struct {
+		email   string
+		wantErr bool
+	}{
+		{email: "bounces@example.com"},
+		{email: "bounces+tag@mail.example.co.jp"},
+		{email: "example.com", wantErr: true},
+		{email: "bounces@example", wantErr: true},
+		{email: "bounces @example.com", wantErr: true},
+	}
+
+	for _, c := range cases {
+		resp := &validator.StringResponse{}
+		forwardBounceEmailValidator.ValidateString(context.Background(), validator.StringRequest{ConfigValue: types.StringValue(c.email)}, resp)
+		if got := resp.Diagnostics.HasError(); got != c.wantErr {
+			t.Errorf("%q: got error %t, want %t", c.email, got, c.wantErr)
+		}
+	}
+}
+
+func testForwardBounceSettingsResourceMockConfig(enabled bool, email string) string {
+	return fmt.Sprintf(`
+provider "sendgrid" {
+	api_key = "SG.test"
+}
+
+resource "sendgrid_forward_bounce_settings" "test" {
+	enabled = %t
+	email   = %q
+}
+`, enabled, email)
+}
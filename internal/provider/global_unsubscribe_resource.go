@@ -0,0 +1,288 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/i10416/sendgrid"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &globalUnsubscribeResource{}
+var _ resource.ResourceWithImportState = &globalUnsubscribeResource{}
+
+func newGlobalUnsubscribeResource() resource.Resource {
+	return &globalUnsubscribeResource{typeName: "_global_unsubscribe"}
+}
+
+// newGlobalSuppressionResource returns sendgrid_global_suppression, which manages the same list as
+// sendgrid_global_unsubscribe under the name the SendGrid API uses for it.
+func newGlobalSuppressionResource() resource.Resource {
+	return &globalUnsubscribeResource{typeName: "_global_suppression"}
+}
+
+type globalUnsubscribeResource struct {
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
+	// typeName is the suffix of the resource type name.
+	typeName string
+}
+
+type globalUnsubscribeResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Email   types.String `tfsdk:"email"`
+	Created types.Int64  `tfsdk:"created"`
+}
+
+func (r *globalUnsubscribeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.typeName
+}
+
+func (r *globalUnsubscribeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Manages an address on the global unsubscribe list.
+
+SendGrid does not deliver any email to a globally unsubscribed address, unlike an unsubscribe group which only suppresses the emails of that group.
+Creating this resource adds the address to the list, and adding an address that is already on the list adopts it into state.
+Destroying it removes the address from the list.
+
+Do not manage the same address with both ` + "`sendgrid_global_unsubscribe`" + ` and ` + "`sendgrid_global_suppression`" + `, since they manage the same list.
+
+For more detailed information, please see the [SendGrid documentation](https://www.twilio.com/docs/sendgrid/api-reference/suppressions-global-suppressions).
+		`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unsubscribed email address",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email address to unsubscribe from every email",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"created": schema.Int64Attribute{
+				MarkdownDescription: "Unix timestamp of when the address was added to the global unsubscribe list",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *globalUnsubscribeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+	r.rateLimits = data.rateLimits
+}
+
+func (r *globalUnsubscribeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan globalUnsubscribeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAPILogFields(ctx, "create", plan.ID)
+
+	email := plan.Email.ValueString()
+	client := sendgridClient{r.client}
+	_, addErr := r.rateLimits.retryNonIdempotent(ctx, rateLimitGroupASM, func() (interface{}, error) {
+		return nil, client.AddGlobalSuppressions(ctx, []string{email})
+	})
+
+	u, err := findGlobalUnsubscribe(ctx, r.rateLimits, client, email)
+	// NOTE: Adding an address that is already on the list may be rejected with an "already exists" error,
+	//       and a failed request may still have added the address. The membership decides instead of the
+	//       error message, so the add is idempotent.
+	if addErr != nil && (err != nil || u == nil) {
+		resp.Diagnostics.AddError(
+			"Creating global unsubscribe",
+			fmt.Sprintf("Unable to add %s to the global unsubscribe list, got error: %s", email, sendgridErrorDetail(addErr)),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Creating global unsubscribe",
+			fmt.Sprintf("Unable to read global unsubscribe (email: %s), got error: %s", email, sendgridErrorDetail(err)),
+		)
+		return
+	}
+	if u == nil {
+		resp.Diagnostics.AddError(
+			"Creating global unsubscribe",
+			fmt.Sprintf("%s was added but is not on the global unsubscribe list.", email),
+		)
+		return
+	}
+
+	plan = globalUnsubscribeModel(email, u)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *globalUnsubscribeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state globalUnsubscribeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAPILogFields(ctx, "read", state.ID)
+
+	email := state.Email.ValueString()
+	u, err := findGlobalUnsubscribe(ctx, r.rateLimits, sendgridClient{r.client}, email)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading global unsubscribe",
+			fmt.Sprintf("Unable to read global unsubscribe (email: %s), got error: %s", email, sendgridErrorDetail(err)),
+		)
+		return
+	}
+	if u == nil {
+		resp.Diagnostics.AddWarning(
+			"Reading global unsubscribe",
+			fmt.Sprintf("Global unsubscribe (email: %s) is no longer on the global unsubscribe list and was removed from state.", email),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state = globalUnsubscribeModel(email, u)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *globalUnsubscribeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data globalUnsubscribeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAPILogFields(ctx, "update", data.ID)
+
+	// NOTE: email requires replacement and created is read from SendGrid, so there is nothing to update.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *globalUnsubscribeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state globalUnsubscribeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAPILogFields(ctx, "delete", state.ID)
+
+	email := state.Email.ValueString()
+	_, err := r.rateLimits.retry(ctx, rateLimitGroupASM, func() (interface{}, error) {
+		return nil, sendgridClient{r.client}.DeleteGlobalSuppression(ctx, email)
+	})
+	// The address is already off the list.
+	if isNotFoundError(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Deleting global unsubscribe",
+			fmt.Sprintf("Unable to remove %s from the global unsubscribe list, got error: %s", email, sendgridErrorDetail(err)),
+		)
+		return
+	}
+}
+
+func (r *globalUnsubscribeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = withAPILogFields(ctx, "import", types.StringValue(req.ID))
+
+	email := req.ID
+	u, err := findGlobalUnsubscribe(ctx, r.rateLimits, sendgridClient{r.client}, email)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Importing global unsubscribe",
+			fmt.Sprintf("Unable to read global unsubscribe (email: %s), got error: %s", email, sendgridErrorDetail(err)),
+		)
+		return
+	}
+	if u == nil {
+		resp.Diagnostics.AddError(
+			"Importing global unsubscribe",
+			fmt.Sprintf("%s is not on the global unsubscribe list.", email),
+		)
+		return
+	}
+
+	data := globalUnsubscribeModel(email, u)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// findGlobalUnsubscribe returns the global unsubscribe of email, or nil when the address is not on the global unsubscribe list.
+func findGlobalUnsubscribe(ctx context.Context, rateLimits *rateLimitBuckets, client sendgridClient, email string) (*globalUnsubscribe, error) {
+	// NOTE: Re-execute after the re-executable time has elapsed when a rate limit occurs
+	res, err := rateLimits.retry(ctx, rateLimitGroupSuppression, func() (interface{}, error) {
+		return client.GetGlobalUnsubscribes(ctx, &sendgrid.SuppressionListOptions{Email: email})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	unsubscribes, ok := res.([]globalUnsubscribe)
+	if !ok {
+		return nil, fmt.Errorf("failed to assert type []globalUnsubscribe")
+	}
+
+	for i := range unsubscribes {
+		if strings.EqualFold(unsubscribes[i].Email, email) {
+			return &unsubscribes[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// globalUnsubscribeModel maps u to the resource model, keeping email as configured since addresses are compared case-insensitively.
+func globalUnsubscribeModel(email string, u *globalUnsubscribe) globalUnsubscribeResourceModel {
+	return globalUnsubscribeResourceModel{
+		ID:      types.StringValue(email),
+		Email:   types.StringValue(email),
+		Created: types.Int64Value(u.Created),
+	}
+}
@@ -0,0 +1,325 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestGlobalUnsubscribeResourceWithMockTransport(t *testing.T) {
+	resourceName := "sendgrid_global_unsubscribe.test"
+
+	rt, unsubscribes := newMockGlobalUnsubscribeTransport("user@example.com", "other@example.com")
+	unsubscribes.add("other@example.com")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactoriesWithTransport(rt),
+		CheckDestroy: func(_ *terraform.State) error {
+			if unsubscribes.has("user@example.com") {
+				return errors.New("global unsubscribe still exists after destroy")
+			}
+			if !unsubscribes.has("other@example.com") {
+				return errors.New("deleting a global unsubscribe removed other addresses")
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testGlobalUnsubscribeResourceMockConfig("user@example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "user@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "email", "user@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "created", "1700000001"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// The address was removed outside of Terraform, so it is removed from state and added again.
+			{
+				PreConfig: func() {
+					unsubscribes.remove("user@example.com")
+				},
+				Config:             testGlobalUnsubscribeResourceMockConfig("user@example.com"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestGlobalUnsubscribeResourceAddAndRemove(t *testing.T) {
+	ctx := context.Background()
+	rt, unsubscribes := newMockGlobalUnsubscribeTransport("user@example.com")
+	r := &globalUnsubscribeResource{client: newMockClient(rt), typeName: "_global_unsubscribe"}
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	s := schemaResp.Schema
+
+	plan := tfsdk.Plan{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+		"id":      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"email":   tftypes.NewValue(tftypes.String, "user@example.com"),
+		"created": tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+	})}
+	createResp := &fwresource.CreateResponse{State: tfsdk.State{Schema: s, Raw: plan.Raw}}
+	r.Create(ctx, fwresource.CreateRequest{Plan: plan}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", createResp.Diagnostics)
+	}
+
+	var got globalUnsubscribeResourceModel
+	createResp.Diagnostics.Append(createResp.State.Get(ctx, &got)...)
+	if got.ID.ValueString() != "user@example.com" || got.Created.ValueInt64() != 1700000001 {
+		t.Errorf("got %+v, want the added global unsubscribe", got)
+	}
+	if !unsubscribes.has("user@example.com") {
+		t.Error("the address was not added to the global unsubscribe list")
+	}
+
+	deleteResp := &fwresource.DeleteResponse{State: createResp.State}
+	r.Delete(ctx, fwresource.DeleteRequest{State: createResp.State}, deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", deleteResp.Diagnostics)
+	}
+	if unsubscribes.has("user@example.com") {
+		t.Error("the address is still on the global unsubscribe list after delete")
+	}
+
+	// Removing an address that is already off the list succeeds.
+	deleteResp = &fwresource.DeleteResponse{State: createResp.State}
+	r.Delete(ctx, fwresource.DeleteRequest{State: createResp.State}, deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", deleteResp.Diagnostics)
+	}
+}
+
+func TestGlobalUnsubscribeResourceImportStateMissing(t *testing.T) {
+	ctx := context.Background()
+	rt, _ := newMockGlobalUnsubscribeTransport()
+	r := &globalUnsubscribeResource{client: newMockClient(rt), typeName: "_global_unsubscribe"}
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	s := schemaResp.Schema
+
+	resp := &fwresource.ImportStateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+	r.ImportState(ctx, fwresource.ImportStateRequest{ID: "missing@example.com"}, resp)
+	if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), "is not on the global unsubscribe list") {
+		t.Errorf("got %v, want an error for an address that is not unsubscribed", resp.Diagnostics)
+	}
+}
+
+func TestGlobalSuppressionResourceWithMockTransport(t *testing.T) {
+	resourceName := "sendgrid_global_suppression.test"
+
+	rt, unsubscribes := newMockGlobalUnsubscribeTransport("user@example.com")
+	unsubscribes.add("user@example.com")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactoriesWithTransport(rt),
+		CheckDestroy: func(_ *terraform.State) error {
+			if unsubscribes.has("user@example.com") {
+				return errors.New("global suppression still exists after destroy")
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// The address is already suppressed, so it is adopted with its created timestamp.
+			{
+				Config: testGlobalSuppressionResourceMockConfig("user@example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "user@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "created", "1700000001"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestGlobalSuppressionResourceIdempotentAdd(t *testing.T) {
+	alreadyExists := func(_ *http.Request) (int, any) {
+		return http.StatusBadRequest, map[string]any{"errors": []map[string]any{{"field": "recipient_emails", "message": "email already exists"}}}
+	}
+
+	cases := []struct {
+		name       string
+		suppressed bool
+		post       mockHandler
+		wantErr    string
+	}{
+		{name: "new address"},
+		{name: "already suppressed", suppressed: true},
+		{name: "already exists error", suppressed: true, post: alreadyExists},
+		{name: "rejected address", post: alreadyExists, wantErr: "Unable to add user@example.com to the global unsubscribe list"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.Background()
+			rt, unsubscribes := newMockGlobalUnsubscribeTransport("user@example.com")
+			if c.suppressed {
+				unsubscribes.add("user@example.com")
+			}
+			if c.post != nil {
+				rt.Handle(http.MethodPost, "/v3/asm/suppressions/global", c.post)
+			}
+			r := newGlobalSuppressionResource().(*globalUnsubscribeResource)
+			r.client = newMockClient(rt)
+
+			schemaResp := &fwresource.SchemaResponse{}
+			r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+			s := schemaResp.Schema
+
+			plan := tfsdk.Plan{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"id":      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"email":   tftypes.NewValue(tftypes.String, "user@example.com"),
+				"created": tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+			})}
+			resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: s, Raw: plan.Raw}}
+			r.Create(ctx, fwresource.CreateRequest{Plan: plan}, resp)
+
+			if c.wantErr != "" {
+				if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), c.wantErr) {
+					t.Errorf("got %v, want an error containing %q", resp.Diagnostics, c.wantErr)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			var got globalUnsubscribeResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+			// The created timestamp of an address that was already suppressed is kept.
+			if got.ID.ValueString() != "user@example.com" || got.Created.ValueInt64() != 1700000001 {
+				t.Errorf("got %+v, want the global suppression of user@example.com", got)
+			}
+		})
+	}
+}
+
+// mockGlobalUnsubscribes is the in-memory store behind the mock global unsubscribe endpoints.
+// Addresses get increasing created timestamps in the order they are added.
+type mockGlobalUnsubscribes struct {
+	mu      sync.Mutex
+	created map[string]int64
+	next    int64
+}
+
+func (u *mockGlobalUnsubscribes) has(email string) bool {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	_, ok := u.created[email]
+	return ok
+}
+
+func (u *mockGlobalUnsubscribes) add(email string) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	// Like SendGrid, adding an address already on the list keeps it unchanged.
+	if _, ok := u.created[email]; !ok {
+		u.next++
+		u.created[email] = 1700000000 + u.next
+	}
+}
+
+func (u *mockGlobalUnsubscribes) remove(email string) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	delete(u.created, email)
+}
+
+// newMockGlobalUnsubscribeTransport returns a mock transport serving the global unsubscribe endpoints,
+// with the delete endpoint served for the given emails, and the store it serves them from.
+func newMockGlobalUnsubscribeTransport(emails ...string) (*mockTransport, *mockGlobalUnsubscribes) {
+	rt := newMockTransport()
+	unsubscribes := &mockGlobalUnsubscribes{created: map[string]int64{}}
+
+	rt.Handle(http.MethodGet, "/v3/suppression/unsubscribes", func(req *http.Request) (int, any) {
+		email := req.URL.Query().Get("email")
+
+		unsubscribes.mu.Lock()
+		defer unsubscribes.mu.Unlock()
+		res := []map[string]any{}
+		for e, created := range unsubscribes.created {
+			if email == "" || strings.EqualFold(e, email) {
+				res = append(res, map[string]any{"email": e, "created": created})
+			}
+		}
+		return http.StatusOK, res
+	})
+
+	rt.Handle(http.MethodPost, "/v3/asm/suppressions/global", func(req *http.Request) (int, any) {
+		var body struct {
+			RecipientEmails []string `json:"recipient_emails"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			return http.StatusBadRequest, nil
+		}
+		for _, e := range body.RecipientEmails {
+			unsubscribes.add(e)
+		}
+		return http.StatusCreated, map[string]any{"recipient_emails": body.RecipientEmails}
+	})
+
+	for _, email := range emails {
+		rt.Handle(http.MethodDelete, "/v3/asm/suppressions/global/"+email, func(_ *http.Request) (int, any) {
+			if !unsubscribes.has(email) {
+				return http.StatusNotFound, map[string]any{"errors": []map[string]any{{"field": nil, "message": "email does not exist"}}}
+			}
+			unsubscribes.remove(email)
+			return http.StatusNoContent, nil
+		})
+	}
+
+	return rt, unsubscribes
+}
+
+func testGlobalUnsubscribeResourceMockConfig(email string) string {
+	return fmt.Sprintf(`
+provider "sendgrid" {
+	api_key = "SG.test"
+}
+
+resource "sendgrid_global_unsubscribe" "test" {
+	email = "%s"
+}
+`, email)
+}
+
+func testGlobalSuppressionResourceMockConfig(email string) string {
+	return fmt.Sprintf(`
+provider "sendgrid" {
+	api_key = "SG.test"
+}
+
+resource "sendgrid_global_suppression" "test" {
+	email = "%s"
+}
+`, email)
+}
@@ -24,7 +24,8 @@ func newInboundParseWebhookDataSource() datasource.DataSource {
 }
 
 type inboundParseWebhookDataSource struct {
-	client *sendgrid.Client
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
 }
 
 type inboundParseWebhookDataSourceModel struct {
@@ -44,16 +45,17 @@ func (d *inboundParseWebhookDataSource) Configure(ctx context.Context, req datas
 		return
 	}
 
-	client, ok := req.ProviderData.(*sendgrid.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = data.client
+	d.rateLimits = data.rateLimits
 }
 
 func (d *inboundParseWebhookDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
@@ -100,7 +102,7 @@ func (d *inboundParseWebhookDataSource) Read(ctx context.Context, req datasource
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading inbound parse webhook",
-			fmt.Sprintf("Unable to get inbound parse webhook by hostname: %s, err: %s", hostname, err.Error()),
+			fmt.Sprintf("Unable to get inbound parse webhook by hostname: %s, err: %s", hostname, sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -24,7 +24,8 @@ func newInboundParseWebhookResource() resource.Resource {
 }
 
 type inboundParseWebhookResource struct {
-	client *sendgrid.Client
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
 }
 
 type inboundParseWebhookResourceModel struct {
@@ -77,18 +78,19 @@ func (r *inboundParseWebhookResource) Configure(ctx context.Context, req resourc
 		return
 	}
 
-	client, ok := req.ProviderData.(*sendgrid.Client)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+	r.rateLimits = data.rateLimits
 }
 
 func (r *inboundParseWebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -98,6 +100,8 @@ func (r *inboundParseWebhookResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "create", plan.Hostname)
+
 	input := &sendgrid.InputCreateInboundParseWebhook{
 		Hostname:  plan.Hostname.ValueString(),
 		URL:       plan.URL.ValueString(),
@@ -105,13 +109,13 @@ func (r *inboundParseWebhookResource) Create(ctx context.Context, req resource.C
 		SendRaw:   plan.SendRaw.ValueBool(),
 	}
 
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
+	res, err := r.rateLimits.retryNonIdempotent(ctx, rateLimitGroupInboundParse, func() (interface{}, error) {
 		return r.client.CreateInboundParseWebhook(context.TODO(), input)
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Creating inbound parse webhook",
-			fmt.Sprintf("Unable to create inbound parse webhook, got error: %s", err),
+			fmt.Sprintf("Unable to create inbound parse webhook, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -132,7 +136,7 @@ func (r *inboundParseWebhookResource) Create(ctx context.Context, req resource.C
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading inbound parse webhook",
-			fmt.Sprintf("Unable to read inbound parse webhook, got error: %s", err),
+			fmt.Sprintf("Unable to read inbound parse webhook, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -161,12 +165,14 @@ func (r *inboundParseWebhookResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "read", state.Hostname)
+
 	hostname := state.Hostname.ValueString()
 	o, err := r.client.GetInboundParseWebhook(ctx, hostname)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading inbound parse webhook",
-			fmt.Sprintf("Unable to read inbound parse webhook, got error: %s", err),
+			fmt.Sprintf("Unable to read inbound parse webhook, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -191,6 +197,8 @@ func (r *inboundParseWebhookResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "update", plan.Hostname)
+
 	input := &sendgrid.InputUpdateInboundParseWebhook{}
 	if !plan.URL.IsNull() {
 		input.URL = plan.URL.ValueString()
@@ -207,7 +215,7 @@ func (r *inboundParseWebhookResource) Update(ctx context.Context, req resource.U
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Updating inbound parse webhook",
-			fmt.Sprintf("Unable to update inbound parse webhook, got error: %s", err),
+			fmt.Sprintf("Unable to update inbound parse webhook, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -231,20 +239,24 @@ func (r *inboundParseWebhookResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "delete", data.Hostname)
+
 	hostname := data.Hostname.ValueString()
-	_, err := retryOnRateLimit(ctx, func() (interface{}, error) {
+	_, err := r.rateLimits.retry(ctx, rateLimitGroupInboundParse, func() (interface{}, error) {
 		return nil, r.client.DeleteInboundParseWebhook(ctx, hostname)
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Deleting inbound parse webhook",
-			fmt.Sprintf("Unable to delete inbound parse webhook (hostname: %s), got error: %s", hostname, err),
+			fmt.Sprintf("Unable to delete inbound parse webhook (hostname: %s), got error: %s", hostname, sendgridErrorDetail(err)),
 		)
 		return
 	}
 }
 
 func (r *inboundParseWebhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = withAPILogFields(ctx, "import", types.StringValue(req.ID))
+
 	hostname := req.ID
 
 	resource.ImportStatePassthroughID(ctx, path.Root("hostname"), req, resp)
@@ -253,7 +265,7 @@ func (r *inboundParseWebhookResource) ImportState(ctx context.Context, req resou
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Importing inbound parse webhook",
-			fmt.Sprintf("Unable to read inbound parse webhook, got error: %s", err),
+			fmt.Sprintf("Unable to read inbound parse webhook, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
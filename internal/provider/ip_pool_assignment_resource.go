@@ -0,0 +1,265 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/i10416/sendgrid"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ipPoolAssignmentResource{}
+var _ resource.ResourceWithImportState = &ipPoolAssignmentResource{}
+
+func newIPPoolAssignmentResource() resource.Resource {
+	return &ipPoolAssignmentResource{}
+}
+
+type ipPoolAssignmentResource struct {
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
+}
+
+type ipPoolAssignmentResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	PoolName types.String `tfsdk:"pool_name"`
+	IP       types.String `tfsdk:"ip"`
+}
+
+func (r *ipPoolAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ip_pool_assignment"
+}
+
+func (r *ipPoolAssignmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Provides the assignment of a dedicated IP address to an IP pool.
+
+If the IP address is removed from the pool outside of Terraform, the assignment is removed from state and planned to be created again.
+Do not use this resource for a pool whose ` + "`ips`" + ` are managed by ` + "`sendgrid_ip_pool`" + `.
+
+For more detailed information, please see the [SendGrid documentation](https://www.twilio.com/docs/sendgrid/api-reference/ip-pools/add-an-ip-address-to-a-pool).
+		`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the assignment, formatted as pool_name/ip",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pool_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the IP pool. Example: marketing",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ip": schema.StringAttribute{
+				MarkdownDescription: "The IP address to assign to the IP pool. Example: 192.0.2.1",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ipPoolAssignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+	r.rateLimits = data.rateLimits
+}
+
+func (r *ipPoolAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ipPoolAssignmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAPILogFields(ctx, "create", plan.ID)
+
+	pool, ip := plan.PoolName.ValueString(), plan.IP.ValueString()
+
+	// NOTE: Re-execute after the re-executable time has elapsed when a rate limit occurs
+	_, err := r.rateLimits.retryNonIdempotent(ctx, rateLimitGroupIPs, func() (interface{}, error) {
+		return nil, r.client.AddIPToPool(ctx, pool, ip)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Creating IP pool assignment",
+			fmt.Sprintf("Unable to add IP address %s to IP pool (name: %s), got error: %s", ip, pool, sendgridErrorDetail(err)),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(ipPoolAssignmentID(pool, ip))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ipPoolAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ipPoolAssignmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAPILogFields(ctx, "read", state.ID)
+
+	pool, ip := state.PoolName.ValueString(), state.IP.ValueString()
+
+	assigned, err := ipAssignedToPool(ctx, r.rateLimits, r.client, pool, ip)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading IP pool assignment",
+			fmt.Sprintf("Unable to read IP address %s, got error: %s", ip, sendgridErrorDetail(err)),
+		)
+		return
+	}
+	if !assigned {
+		resp.Diagnostics.AddWarning(
+			"Reading IP pool assignment",
+			fmt.Sprintf("IP address %s is no longer in IP pool (name: %s) and was removed from state, so it will be assigned again.", ip, pool),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ipPoolAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state ipPoolAssignmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAPILogFields(ctx, "update", state.ID)
+
+	// NOTE: Every attribute requires replacement, so there is nothing to update in SendGrid.
+	data.ID = state.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ipPoolAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ipPoolAssignmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAPILogFields(ctx, "delete", state.ID)
+
+	pool, ip := state.PoolName.ValueString(), state.IP.ValueString()
+	_, err := r.rateLimits.retry(ctx, rateLimitGroupIPs, func() (interface{}, error) {
+		return nil, r.client.RemoveIPFromPool(ctx, pool, ip)
+	})
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Deleting IP pool assignment",
+			fmt.Sprintf("Unable to remove IP address %s from IP pool (name: %s), got error: %s", ip, pool, sendgridErrorDetail(err)),
+		)
+		return
+	}
+}
+
+func (r *ipPoolAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = withAPILogFields(ctx, "import", types.StringValue(req.ID))
+
+	// id = pool_name/ip
+	pool, ip, ok := strings.Cut(req.ID, "/")
+	if !ok || pool == "" || ip == "" {
+		resp.Diagnostics.AddError(
+			"Importing IP pool assignment",
+			"Unable to import IP pool assignment, id must be in the format of pool_name/ip",
+		)
+		return
+	}
+
+	assigned, err := ipAssignedToPool(ctx, r.rateLimits, r.client, pool, ip)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Importing IP pool assignment",
+			fmt.Sprintf("Unable to read IP address %s, got error: %s", ip, sendgridErrorDetail(err)),
+		)
+		return
+	}
+	if !assigned {
+		resp.Diagnostics.AddError(
+			"Importing IP pool assignment",
+			fmt.Sprintf("IP address %s is not in IP pool (name: %s)", ip, pool),
+		)
+		return
+	}
+
+	data := ipPoolAssignmentResourceModel{
+		ID:       types.StringValue(ipPoolAssignmentID(pool, ip)),
+		PoolName: types.StringValue(pool),
+		IP:       types.StringValue(ip),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func ipPoolAssignmentID(pool, ip string) string {
+	return pool + "/" + ip
+}
+
+// ipAssignedToPool reports whether ip is in pool. An IP address that no longer exists is in no pool.
+func ipAssignedToPool(ctx context.Context, rateLimits *rateLimitBuckets, client *sendgrid.Client, pool, ip string) (bool, error) {
+	res, err := rateLimits.retry(ctx, rateLimitGroupIPs, func() (interface{}, error) {
+		return client.GetIPAddress(ctx, ip)
+	})
+	if isNotFoundError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	o, ok := res.(*sendgrid.IPAddress)
+	if !ok {
+		return false, fmt.Errorf("failed to assert type *sendgrid.IPAddress")
+	}
+	return slices.Contains(o.Pools, pool), nil
+}
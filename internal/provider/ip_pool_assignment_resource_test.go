@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestIPPoolAssignmentResourceRead(t *testing.T) {
+	ctx := context.Background()
+
+	cases := []struct {
+		name         string
+		status       int
+		body         any
+		wantRemoved  bool
+		wantWarnings int
+	}{
+		{
+			name:   "assigned",
+			status: http.StatusOK,
+			body:   map[string]any{"ip": "192.0.2.1", "pools": []string{"transactional", "marketing"}},
+		},
+		{
+			name:         "removed from the pool",
+			status:       http.StatusOK,
+			body:         map[string]any{"ip": "192.0.2.1", "pools": []string{"transactional"}},
+			wantRemoved:  true,
+			wantWarnings: 1,
+		},
+		{
+			name:         "in no pool",
+			status:       http.StatusOK,
+			body:         map[string]any{"ip": "192.0.2.1"},
+			wantRemoved:  true,
+			wantWarnings: 1,
+		},
+		{
+			name:         "ip address no longer exists",
+			status:       http.StatusNotFound,
+			body:         map[string]any{"errors": []map[string]any{{"field": nil, "message": "resource not found"}}},
+			wantRemoved:  true,
+			wantWarnings: 1,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rt := newMockTransport()
+			rt.Handle(http.MethodGet, "/v3/ips/192.0.2.1", func(_ *http.Request) (int, any) {
+				return c.status, c.body
+			})
+			r := &ipPoolAssignmentResource{client: newMockClient(rt)}
+
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			s := schemaResp.Schema
+
+			state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"id":        tftypes.NewValue(tftypes.String, "marketing/192.0.2.1"),
+				"pool_name": tftypes.NewValue(tftypes.String, "marketing"),
+				"ip":        tftypes.NewValue(tftypes.String, "192.0.2.1"),
+			})}
+			resp := &resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if resp.Diagnostics.WarningsCount() != c.wantWarnings {
+				t.Errorf("got %v, want %d warnings", resp.Diagnostics, c.wantWarnings)
+			}
+			if got := resp.State.Raw.IsNull(); got != c.wantRemoved {
+				t.Errorf("got state removed %t, want %t", got, c.wantRemoved)
+			}
+		})
+	}
+}
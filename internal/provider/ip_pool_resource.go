@@ -0,0 +1,342 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/i10416/sendgrid"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ipPoolResource{}
+var _ resource.ResourceWithImportState = &ipPoolResource{}
+
+// ipAddressesPageSize is the number of IP addresses requested per page, which is the maximum SendGrid allows.
+const ipAddressesPageSize = 500
+
+// ipAddressPager lists the IP addresses of the account page by page.
+type ipAddressPager interface {
+	GetIPAddressesPage(ctx context.Context, limit, offset int) ([]sendgrid.IPAddress, error)
+}
+
+func newIPPoolResource() resource.Resource {
+	return &ipPoolResource{}
+}
+
+type ipPoolResource struct {
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
+}
+
+type ipPoolResourceModel struct {
+	ID   types.String   `tfsdk:"id"`
+	Name types.String   `tfsdk:"name"`
+	IPs  []types.String `tfsdk:"ips"`
+}
+
+func (r *ipPoolResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ip_pool"
+}
+
+func (r *ipPoolResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Provides an IP pool, which groups dedicated IP addresses to send a type of email from.
+
+When ` + "`ips`" + ` is omitted, the membership of the pool is not managed, so it can be managed with ` + "`sendgrid_ip_pool_assignment`" + ` instead.
+
+For more detailed information, please see the [SendGrid documentation](https://www.twilio.com/docs/sendgrid/api-reference/ip-pools).
+		`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The name of the IP pool",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the IP pool. Example: marketing",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ips": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The IP addresses in the IP pool. Example: [\"192.0.2.1\"]",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *ipPoolResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+	r.rateLimits = data.rateLimits
+}
+
+func (r *ipPoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ipPoolResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAPILogFields(ctx, "create", plan.Name)
+
+	name := plan.Name.ValueString()
+
+	// NOTE: Re-execute after the re-executable time has elapsed when a rate limit occurs
+	_, err := r.rateLimits.retryNonIdempotent(ctx, rateLimitGroupIPs, func() (interface{}, error) {
+		return r.client.CreateIPPool(ctx, name)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Creating IP pool",
+			fmt.Sprintf("Unable to create IP pool (name: %s), got error: %s", name, sendgridErrorDetail(err)),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(name)
+	// NOTE: Save the pool before adding IPs, so a failure to add one does not leave the pool untracked.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), plan.Name)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, ip := range plan.IPs {
+		if err := r.addIP(ctx, name, ip.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Creating IP pool",
+				fmt.Sprintf("Unable to add IP address %s to IP pool (name: %s), got error: %s", ip.ValueString(), name, sendgridErrorDetail(err)),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ipPoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ipPoolResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAPILogFields(ctx, "read", state.ID)
+
+	name := state.ID.ValueString()
+
+	_, err := r.rateLimits.retry(ctx, rateLimitGroupIPs, func() (interface{}, error) {
+		return r.client.GetIPPool(ctx, name)
+	})
+	if isNotFoundError(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading IP pool",
+			fmt.Sprintf("Unable to read IP pool (name: %s), got error: %s", name, sendgridErrorDetail(err)),
+		)
+		return
+	}
+
+	state.Name = types.StringValue(name)
+
+	// NOTE: The membership is only refreshed when it is managed by this resource.
+	if state.IPs != nil {
+		ips, err := listIPPoolMembers(ctx, r.rateLimits, sendgridClient{r.client}, name)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Reading IP pool",
+				fmt.Sprintf("Unable to read the IP addresses of IP pool (name: %s), got error: %s", name, sendgridErrorDetail(err)),
+			)
+			return
+		}
+		state.IPs = []types.String{}
+		for _, ip := range ips {
+			state.IPs = append(state.IPs, types.StringValue(ip))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ipPoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state ipPoolResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAPILogFields(ctx, "update", state.ID)
+
+	name := state.ID.ValueString()
+
+	// NOTE: When ips is removed from the configuration, the IPs stay in the pool and are no longer managed.
+	if data.IPs != nil {
+		desired := []string{}
+		for _, ip := range data.IPs {
+			desired = append(desired, ip.ValueString())
+		}
+		current := []string{}
+		for _, ip := range state.IPs {
+			current = append(current, ip.ValueString())
+		}
+
+		toAdd, toRemove := diffSets(desired, current)
+		for _, ip := range toRemove {
+			_, err := r.rateLimits.retry(ctx, rateLimitGroupIPs, func() (interface{}, error) {
+				return nil, r.client.RemoveIPFromPool(ctx, name, ip)
+			})
+			if err != nil && !isNotFoundError(err) {
+				resp.Diagnostics.AddError(
+					"Updating IP pool",
+					fmt.Sprintf("Unable to remove IP address %s from IP pool (name: %s), got error: %s", ip, name, sendgridErrorDetail(err)),
+				)
+				return
+			}
+		}
+		for _, ip := range toAdd {
+			if err := r.addIP(ctx, name, ip); err != nil {
+				resp.Diagnostics.AddError(
+					"Updating IP pool",
+					fmt.Sprintf("Unable to add IP address %s to IP pool (name: %s), got error: %s", ip, name, sendgridErrorDetail(err)),
+				)
+				return
+			}
+		}
+	}
+
+	data.ID = state.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ipPoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ipPoolResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAPILogFields(ctx, "delete", state.ID)
+
+	name := state.ID.ValueString()
+	_, err := r.rateLimits.retry(ctx, rateLimitGroupIPs, func() (interface{}, error) {
+		return nil, r.client.DeleteIPPool(ctx, name)
+	})
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Deleting IP pool",
+			fmt.Sprintf("Unable to delete IP pool (name: %s), got error: %s", name, sendgridErrorDetail(err)),
+		)
+		return
+	}
+}
+
+func (r *ipPoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = withAPILogFields(ctx, "import", types.StringValue(req.ID))
+
+	name := req.ID
+	ips, err := listIPPoolMembers(ctx, r.rateLimits, sendgridClient{r.client}, name)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Importing IP pool",
+			fmt.Sprintf("Unable to read the IP addresses of IP pool (name: %s), got error: %s", name, sendgridErrorDetail(err)),
+		)
+		return
+	}
+
+	data := ipPoolResourceModel{
+		ID:   types.StringValue(name),
+		Name: types.StringValue(name),
+		IPs:  []types.String{},
+	}
+	for _, ip := range ips {
+		data.IPs = append(data.IPs, types.StringValue(ip))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ipPoolResource) addIP(ctx context.Context, pool, ip string) error {
+	_, err := r.rateLimits.retryNonIdempotent(ctx, rateLimitGroupIPs, func() (interface{}, error) {
+		return nil, r.client.AddIPToPool(ctx, pool, ip)
+	})
+	return err
+}
+
+// listIPPoolMembers returns the IP addresses in pool.
+// SendGrid does not paginate the IPs of a single pool, so every IP address of the account is paginated through
+// and filtered by the pools it belongs to, which keeps the membership complete for accounts with many IPs.
+func listIPPoolMembers(ctx context.Context, rateLimits *rateLimitBuckets, client ipAddressPager, pool string) ([]string, error) {
+	ips, err := paginateAll(ctx, ipAddressesPageSize, func(ctx context.Context, limit, offset int) ([]sendgrid.IPAddress, error) {
+		// NOTE: Re-execute after the re-executable time has elapsed when a rate limit occurs
+		res, err := rateLimits.retry(ctx, rateLimitGroupIPs, func() (interface{}, error) {
+			return client.GetIPAddressesPage(ctx, limit, offset)
+		})
+		if err != nil {
+			return nil, err
+		}
+		page, ok := res.([]sendgrid.IPAddress)
+		if !ok {
+			return nil, fmt.Errorf("failed to assert type []sendgrid.IPAddress")
+		}
+		return page, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	members := []string{}
+	for _, ip := range ips {
+		if slices.Contains(ip.Pools, pool) {
+			members = append(members, ip.IP)
+		}
+	}
+	return members, nil
+}
@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/i10416/sendgrid"
+)
+
+// newMockIPAddressesTransport serves ips page by page from GET /v3/ips, honoring limit and offset like SendGrid,
+// and the pools they belong to from GET /v3/ips/pools/{name}.
+func newMockIPAddressesTransport(ips []sendgrid.IPAddress) *mockTransport {
+	rt := newMockTransport()
+	rt.Handle(http.MethodGet, "/v3/ips", func(req *http.Request) (int, any) {
+		limit, _ := strconv.Atoi(req.URL.Query().Get("limit"))
+		offset, _ := strconv.Atoi(req.URL.Query().Get("offset"))
+		end := min(offset+limit, len(ips))
+		if offset >= end {
+			return http.StatusOK, []sendgrid.IPAddress{}
+		}
+		return http.StatusOK, ips[offset:end]
+	})
+	pools := map[string]struct{}{}
+	for _, ip := range ips {
+		for _, p := range ip.Pools {
+			pools[p] = struct{}{}
+		}
+	}
+	for p := range pools {
+		rt.Handle(http.MethodGet, "/v3/ips/pools/"+p, func(_ *http.Request) (int, any) {
+			return http.StatusOK, map[string]any{"pool_name": p}
+		})
+	}
+	return rt
+}
+
+// mockIPAddresses returns n IP addresses, every third of which is in the marketing pool.
+func mockIPAddresses(n int) (ips []sendgrid.IPAddress, marketing []string) {
+	for i := range n {
+		ip := sendgrid.IPAddress{IP: fmt.Sprintf("10.0.%d.%d", i/256, i%256), Pools: []string{"transactional"}}
+		if i%3 == 0 {
+			ip.Pools = []string{"marketing"}
+			marketing = append(marketing, ip.IP)
+		}
+		ips = append(ips, ip)
+	}
+	return ips, marketing
+}
+
+func TestListIPPoolMembers(t *testing.T) {
+	ips, want := mockIPAddresses(2*ipAddressesPageSize + 3)
+	rt := newMockIPAddressesTransport(ips)
+
+	got, err := listIPPoolMembers(context.Background(), nil, sendgridClient{newMockClient(rt)}, "marketing")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(got) != len(want) || got[0] != want[0] || got[len(got)-1] != want[len(want)-1] {
+		t.Errorf("got %d members (%v..), want %d members", len(got), got[:min(3, len(got))], len(want))
+	}
+	if n := len(rt.Requests()); n != 3 {
+		t.Errorf("got %d requests, want 3 pages", n)
+	}
+}
+
+func TestIPPoolResourceReadPaginatesMembers(t *testing.T) {
+	ctx := context.Background()
+
+	ips, want := mockIPAddresses(2*ipAddressesPageSize + 3)
+	r := &ipPoolResource{client: newMockClient(newMockIPAddressesTransport(ips))}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	s := schemaResp.Schema
+
+	// The state only knows the first member, as if the others were added outside of Terraform.
+	state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+		"id":   tftypes.NewValue(tftypes.String, "marketing"),
+		"name": tftypes.NewValue(tftypes.String, "marketing"),
+		"ips": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, want[0]),
+		}),
+	})}
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var got ipPoolResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if len(got.IPs) != len(want) {
+		t.Fatalf("got %d IPs, want every one of the %d members across the pages", len(got.IPs), len(want))
+	}
+	for i, ip := range want {
+		if got.IPs[i] != types.StringValue(ip) {
+			t.Errorf("got IP %s at %d, want %s", got.IPs[i], i, ip)
+		}
+	}
+}
+
+func TestIPPoolResourceReadUnmanagedMembers(t *testing.T) {
+	ctx := context.Background()
+
+	ips, _ := mockIPAddresses(3)
+	rt := newMockIPAddressesTransport(ips)
+	r := &ipPoolResource{client: newMockClient(rt)}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	s := schemaResp.Schema
+
+	state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+		"id":   tftypes.NewValue(tftypes.String, "marketing"),
+		"name": tftypes.NewValue(tftypes.String, "marketing"),
+		"ips":  tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+	})}
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var got ipPoolResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	if got.IPs != nil {
+		t.Errorf("got IPs %v, want them to stay unmanaged", got.IPs)
+	}
+	for _, req := range rt.Requests() {
+		if req.URL.Path == "/v3/ips" {
+			t.Error("got a request for the IP addresses, want none when ips is not managed")
+		}
+	}
+}
@@ -0,0 +1,383 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/i10416/sendgrid"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ipWarmupResource{}
+var _ resource.ResourceWithImportState = &ipWarmupResource{}
+
+// ipWarmupDuration is the length of SendGrid's automated warmup schedule, after which an IP address
+// sends without the hourly limits of the warmup.
+// see: https://www.twilio.com/docs/sendgrid/ui/sending-email/warming-up-an-ip-address
+const ipWarmupDuration = 41 * 24 * time.Hour
+
+// ipWarmupPollInterval is how often the warmup is read while waiting for its completion.
+const ipWarmupPollInterval = time.Minute
+
+func newIPWarmupResource() resource.Resource {
+	return &ipWarmupResource{}
+}
+
+type ipWarmupResource struct {
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
+}
+
+type ipWarmupResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	IP                types.String `tfsdk:"ip"`
+	StartDate         types.Int64  `tfsdk:"start_date"`
+	WarmupComplete    types.Bool   `tfsdk:"warmup_complete"`
+	WaitForCompletion types.Bool   `tfsdk:"wait_for_completion"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *ipWarmupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ip_warmup"
+}
+
+func (r *ipWarmupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Provides the warmup of a dedicated IP address, which SendGrid's automated warmup schedule limits the hourly volume of until the IP address builds its reputation.
+
+The warmup is complete once the schedule has elapsed since ` + "`start_date`" + ` or SendGrid no longer reports the IP address in warmup. Destroying this resource stops the warmup if it is still in progress.
+
+For more detailed information, please see the [SendGrid documentation](https://www.twilio.com/docs/sendgrid/ui/sending-email/warming-up-an-ip-address).
+		`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The IP address in warmup.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ip": schema.StringAttribute{
+				MarkdownDescription: "The dedicated IP address to warm up.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"start_date": schema.Int64Attribute{
+				MarkdownDescription: "A Unix epoch timestamp representing when the warmup started.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"warmup_complete": schema.BoolAttribute{
+				MarkdownDescription: "Indicates if the warmup has ended, either because the warmup schedule has elapsed since `start_date` or because SendGrid no longer reports the IP address in warmup.",
+				Computed:            true,
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				MarkdownDescription: "Wait on create and update until the warmup is complete, bounded by the `create` and `update` timeouts. A create that times out keeps the started warmup and reports a warning, so that applying again keeps waiting. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *ipWarmupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+	r.rateLimits = data.rateLimits
+}
+
+func (r *ipWarmupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ipWarmupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAPILogFields(ctx, "create", plan.IP)
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	ip := plan.IP.ValueString()
+	client := sendgridClient{r.client}
+	res, err := r.rateLimits.retryNonIdempotent(ctx, rateLimitGroupIPs, func() (interface{}, error) {
+		return client.StartIPWarmup(ctx, ip)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Creating IP warmup",
+			fmt.Sprintf("Unable to start the warmup of %s, got error: %s", ip, sendgridErrorDetail(err)),
+		)
+		return
+	}
+	o, ok := res.(*ipWarmup)
+	if !ok || o == nil {
+		resp.Diagnostics.AddError(
+			"Creating IP warmup",
+			fmt.Sprintf("SendGrid did not report %s in warmup after starting it", ip),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(ip)
+	plan.StartDate = types.Int64Value(o.StartDate)
+	plan.WarmupComplete = types.BoolValue(ipWarmupComplete(o, time.Now()))
+
+	if plan.WaitForCompletion.ValueBool() && !plan.WarmupComplete.ValueBool() {
+		// NOTE: A failed wait is a warning, since an error would taint the resource and replacing it
+		//       would restart the warmup that has already begun.
+		if err := r.waitForCompletion(ctx, &plan); err != nil {
+			resp.Diagnostics.AddWarning(
+				"Creating IP warmup",
+				fmt.Sprintf("The warmup of %s has started, but waiting for it to complete failed with error: %s. Apply again to keep waiting.", ip, sendgridErrorDetail(err)),
+			)
+		}
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ipWarmupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ipWarmupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAPILogFields(ctx, "read", state.ID)
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	o, err := r.getIPWarmup(ctx, state.IP.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading IP warmup",
+			fmt.Sprintf("Unable to read the warmup of %s, got error: %s", state.IP.ValueString(), sendgridErrorDetail(err)),
+		)
+		return
+	}
+
+	// NOTE: An IP address that left warmup stays in the state as complete, so that the warmup is not started again.
+	if o != nil {
+		state.StartDate = types.Int64Value(o.StartDate)
+	}
+	state.WarmupComplete = types.BoolValue(ipWarmupComplete(o, time.Now()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ipWarmupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ipWarmupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAPILogFields(ctx, "update", state.ID)
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// NOTE: Only wait_for_completion and timeouts can change in place, so the warmup is kept as it is.
+	plan.ID = state.ID
+	plan.StartDate = state.StartDate
+	plan.WarmupComplete = state.WarmupComplete
+	if plan.WaitForCompletion.ValueBool() && !plan.WarmupComplete.ValueBool() {
+		if err := r.waitForCompletion(ctx, &plan); err != nil {
+			resp.Diagnostics.AddError(
+				"Updating IP warmup",
+				fmt.Sprintf("Unable to wait for the warmup of %s to complete, got error: %s", plan.IP.ValueString(), sendgridErrorDetail(err)),
+			)
+			return
+		}
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ipWarmupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ipWarmupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAPILogFields(ctx, "delete", state.ID)
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	ip := state.IP.ValueString()
+	client := sendgridClient{r.client}
+	_, err := r.rateLimits.retry(ctx, rateLimitGroupIPs, func() (interface{}, error) {
+		return nil, client.StopIPWarmup(ctx, ip)
+	})
+	// NOTE: An IP address that already left warmup has nothing to stop.
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Deleting IP warmup",
+			fmt.Sprintf("Unable to stop the warmup of %s, got error: %s", ip, sendgridErrorDetail(err)),
+		)
+		return
+	}
+}
+
+func (r *ipWarmupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = withAPILogFields(ctx, "import", types.StringValue(req.ID))
+
+	o, err := r.getIPWarmup(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Importing IP warmup",
+			fmt.Sprintf("Unable to read the warmup of %s, got error: %s", req.ID, sendgridErrorDetail(err)),
+		)
+		return
+	}
+	if o == nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ip"),
+			"Importing IP warmup",
+			fmt.Sprintf("%s is not in warmup", req.ID),
+		)
+		return
+	}
+
+	data := ipWarmupResourceModel{
+		ID:                types.StringValue(o.IP),
+		IP:                types.StringValue(o.IP),
+		StartDate:         types.Int64Value(o.StartDate),
+		WarmupComplete:    types.BoolValue(ipWarmupComplete(o, time.Now())),
+		WaitForCompletion: types.BoolValue(false),
+		Timeouts:          nullTimeouts(),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// getIPWarmup reads the warmup of ip, which is nil if ip is not in warmup.
+func (r *ipWarmupResource) getIPWarmup(ctx context.Context, ip string) (*ipWarmup, error) {
+	client := sendgridClient{r.client}
+	// NOTE: Re-execute after the re-executable time has elapsed when a rate limit occurs
+	res, err := r.rateLimits.retry(ctx, rateLimitGroupIPs, func() (interface{}, error) {
+		return client.GetIPWarmup(ctx, ip)
+	})
+	if err != nil {
+		return nil, err
+	}
+	o, ok := res.(*ipWarmup)
+	if !ok {
+		return nil, fmt.Errorf("failed to assert type *ipWarmup")
+	}
+	return o, nil
+}
+
+// waitForCompletion waits until the warmup of m is complete and updates m with the warmup it read last.
+func (r *ipWarmupResource) waitForCompletion(ctx context.Context, m *ipWarmupResourceModel) error {
+	ip := m.IP.ValueString()
+	return pollIPWarmup(ctx, ipWarmupPollInterval, func(ctx context.Context) (bool, error) {
+		o, err := r.getIPWarmup(ctx, ip)
+		if err != nil {
+			return false, err
+		}
+		if o != nil {
+			m.StartDate = types.Int64Value(o.StartDate)
+		}
+		complete := ipWarmupComplete(o, time.Now())
+		m.WarmupComplete = types.BoolValue(complete)
+		return complete, nil
+	})
+}
+
+// ipWarmupComplete reports if warmup o has ended at now. A nil o is an IP address SendGrid no longer reports in warmup.
+func ipWarmupComplete(o *ipWarmup, now time.Time) bool {
+	if o == nil {
+		return true
+	}
+	return !now.Before(time.Unix(o.StartDate, 0).Add(ipWarmupDuration))
+}
+
+// pollIPWarmup calls complete every interval until it reports the warmup complete, it fails, or ctx is done.
+func pollIPWarmup(ctx context.Context, interval time.Duration, complete func(ctx context.Context) (bool, error)) error {
+	for {
+		done, err := complete(ctx)
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("the warmup is still in progress: %w", ctx.Err())
+		case <-time.After(interval):
+		}
+	}
+}
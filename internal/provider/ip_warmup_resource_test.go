@@ -0,0 +1,218 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestIPWarmupResourceWithMockTransport(t *testing.T) {
+	resourceName := "sendgrid_ip_warmup.test"
+	// The warmup started before the schedule, so it is complete as soon as it is read.
+	rt := newMockIPWarmupTransport(time.Now().Add(-ipWarmupDuration).Unix())
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactoriesWithTransport(rt),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testIPWarmupResourceMockConfig("192.0.2.1", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "192.0.2.1"),
+					resource.TestCheckResourceAttrSet(resourceName, "start_date"),
+					resource.TestCheckResourceAttr(resourceName, "warmup_complete", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_completion"},
+			},
+		},
+	})
+}
+
+func TestIPWarmupComplete(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(ipWarmupDuration)
+
+	cases := []struct {
+		name   string
+		warmup *ipWarmup
+		now    time.Time
+		want   bool
+	}{
+		{name: "just started", warmup: &ipWarmup{IP: "192.0.2.1", StartDate: start.Unix()}, now: start, want: false},
+		{name: "before the end of the schedule", warmup: &ipWarmup{IP: "192.0.2.1", StartDate: start.Unix()}, now: end.Add(-time.Second), want: false},
+		{name: "at the end of the schedule", warmup: &ipWarmup{IP: "192.0.2.1", StartDate: start.Unix()}, now: end, want: true},
+		{name: "after the end of the schedule", warmup: &ipWarmup{IP: "192.0.2.1", StartDate: start.Unix()}, now: end.Add(24 * time.Hour), want: true},
+		{name: "no longer in warmup", warmup: nil, now: start, want: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := ipWarmupComplete(c.warmup, c.now); got != c.want {
+				t.Errorf("got %t, want %t", got, c.want)
+			}
+		})
+	}
+}
+
+func TestPollIPWarmup(t *testing.T) {
+	t.Run("completes", func(t *testing.T) {
+		calls := 0
+		err := pollIPWarmup(context.Background(), time.Millisecond, func(context.Context) (bool, error) {
+			calls++
+			return calls == 3, nil
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if calls != 3 {
+			t.Errorf("got %d calls, want 3", calls)
+		}
+	})
+
+	t.Run("fails", func(t *testing.T) {
+		want := errors.New("boom")
+		err := pollIPWarmup(context.Background(), time.Millisecond, func(context.Context) (bool, error) {
+			return false, want
+		})
+		if !errors.Is(err, want) {
+			t.Errorf("got %v, want %v", err, want)
+		}
+	})
+
+	t.Run("times out", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		err := pollIPWarmup(ctx, time.Millisecond, func(context.Context) (bool, error) {
+			return false, nil
+		})
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("got %v, want the deadline to be exceeded", err)
+		}
+	})
+}
+
+func TestIPWarmupResourceWaitForCompletion(t *testing.T) {
+	ctx := context.Background()
+	rt := newMockIPWarmupTransport(time.Now().Unix())
+	r := &ipWarmupResource{client: newMockClient(rt)}
+	client := sendgridClient{r.client}
+
+	if _, err := client.StartIPWarmup(ctx, "192.0.2.1"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The warmup has just started, so waiting is bounded by the timeout.
+	m := &ipWarmupResourceModel{IP: types.StringValue("192.0.2.1")}
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if err := r.waitForCompletion(timeoutCtx, m); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got %v, want the deadline to be exceeded", err)
+	}
+	if m.WarmupComplete.ValueBool() {
+		t.Error("got the warmup complete, want it in progress")
+	}
+
+	// Once SendGrid no longer reports the IP address in warmup, it is complete.
+	if err := client.StopIPWarmup(ctx, "192.0.2.1"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := r.waitForCompletion(ctx, m); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !m.WarmupComplete.ValueBool() {
+		t.Error("got the warmup in progress, want it complete")
+	}
+}
+
+func TestGetIPWarmupNotInWarmup(t *testing.T) {
+	rt := newMockTransport()
+	rt.Handle(http.MethodGet, "/v3/ips/warmup/192.0.2.1", func(_ *http.Request) (int, any) {
+		return http.StatusNotFound, map[string]any{"errors": []map[string]any{{"field": nil, "message": "Resource not found"}}}
+	})
+
+	got, err := sendgridClient{newMockClient(rt)}.GetIPWarmup(context.Background(), "192.0.2.1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got != nil {
+		t.Errorf("got %+v, want nil", got)
+	}
+}
+
+// newMockIPWarmupTransport returns a mock transport serving the IP warmup endpoints of 192.0.2.1.
+// The IP address starts its warmup at startDate.
+func newMockIPWarmupTransport(startDate int64) *mockTransport {
+	rt := newMockTransport()
+	var mu sync.Mutex
+	warmups := map[string]int64{}
+
+	list := func(ip string) []map[string]any {
+		if _, ok := warmups[ip]; !ok {
+			return []map[string]any{}
+		}
+		return []map[string]any{{"ip": ip, "start_date": warmups[ip]}}
+	}
+
+	rt.Handle(http.MethodPost, "/v3/ips/warmup", func(req *http.Request) (int, any) {
+		var in struct {
+			IP string `json:"ip"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&in); err != nil || in.IP == "" {
+			return http.StatusBadRequest, nil
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		warmups[in.IP] = startDate
+		return http.StatusOK, list(in.IP)
+	})
+	const ip = "192.0.2.1"
+	rt.Handle(http.MethodGet, "/v3/ips/warmup/"+ip, func(_ *http.Request) (int, any) {
+		mu.Lock()
+		defer mu.Unlock()
+		if _, ok := warmups[ip]; !ok {
+			return http.StatusNotFound, map[string]any{"errors": []map[string]any{{"field": nil, "message": "Resource not found"}}}
+		}
+		return http.StatusOK, list(ip)
+	})
+	rt.Handle(http.MethodDelete, "/v3/ips/warmup/"+ip, func(_ *http.Request) (int, any) {
+		mu.Lock()
+		defer mu.Unlock()
+		if _, ok := warmups[ip]; !ok {
+			return http.StatusNotFound, nil
+		}
+		delete(warmups, ip)
+		return http.StatusNoContent, nil
+	})
+
+	return rt
+}
+
+func testIPWarmupResourceMockConfig(ip string, wait bool) string {
+	return fmt.Sprintf(`
+provider "sendgrid" {
+	api_key = "SG.test"
+}
+
+resource "sendgrid_ip_warmup" "test" {
+	ip                  = %q
+	wait_for_completion = %t
+}
+`, ip, wait)
+}
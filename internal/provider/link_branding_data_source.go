@@ -25,7 +25,8 @@ func newLinkBrandingDataSource() datasource.DataSource {
 }
 
 type linkBrandingDataSource struct {
-	client *sendgrid.Client
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
 }
 
 type linkBrandingDataSourceModel struct {
@@ -50,16 +51,17 @@ func (d *linkBrandingDataSource) Configure(ctx context.Context, req datasource.C
 		return
 	}
 
-	client, ok := req.ProviderData.(*sendgrid.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = data.client
+	d.rateLimits = data.rateLimits
 }
 
 func (d *linkBrandingDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
@@ -146,7 +148,7 @@ func (d *linkBrandingDataSource) Read(ctx context.Context, req datasource.ReadRe
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading link branding",
-			fmt.Sprintf("Unable to get branded link, got error: %s", err),
+			fmt.Sprintf("Unable to get branded link, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -159,6 +161,7 @@ func (d *linkBrandingDataSource) Read(ctx context.Context, req datasource.ReadRe
 	s.Legacy = types.BoolValue(o.Legacy)
 	s.Valid = types.BoolValue(o.Valid)
 	s.DNS = convertDNSBrandedLinkToSetType(o.DNS)
+	resp.Diagnostics.Append(checkBrandedLinkDNSRecords(o.DNS)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &s)...)
 	if resp.Diagnostics.HasError() {
@@ -8,7 +8,9 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -31,7 +33,8 @@ func newLinkBrandingResource() resource.Resource {
 }
 
 type linkBrandingResource struct {
-	client *sendgrid.Client
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
 }
 
 type linkBrandingResourceModel struct {
@@ -44,6 +47,8 @@ type linkBrandingResourceModel struct {
 	Legacy    types.Bool   `tfsdk:"legacy"`
 	Valid     types.Bool   `tfsdk:"valid"`
 	DNS       types.Set    `tfsdk:"dns"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *linkBrandingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -98,9 +103,12 @@ For more detailed information, please see the [SendGrid documentation](https://d
 				},
 			},
 			"default": schema.BoolAttribute{
-				MarkdownDescription: "Indicates if this is the default link branding.",
+				MarkdownDescription: "Indicates if this is the default link branding. Only one link branding can be the default, so making another link branding the default unsets this flag.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"legacy": schema.BoolAttribute{
 				MarkdownDescription: "Indicates if this link branding was created using the legacy whitelabel tool. If it is a legacy whitelabel, it will still function, but you'll need to create new link branding if you need to update it.",
@@ -144,6 +152,9 @@ For more detailed information, please see the [SendGrid documentation](https://d
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -152,18 +163,19 @@ func (r *linkBrandingResource) Configure(ctx context.Context, req resource.Confi
 		return
 	}
 
-	client, ok := req.ProviderData.(*sendgrid.Client)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+	r.rateLimits = data.rateLimits
 }
 
 func (r *linkBrandingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -173,6 +185,16 @@ func (r *linkBrandingResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "create", data.ID)
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	domain := data.Domain.ValueString()
 	input := &sendgrid.InputCreateBrandedLink{
 		Domain: domain,
@@ -187,13 +209,13 @@ func (r *linkBrandingResource) Create(ctx context.Context, req resource.CreateRe
 		input.Default = def
 	}
 
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-		return r.client.CreateBrandedLink(context.TODO(), input)
+	res, err := r.rateLimits.retryNonIdempotent(ctx, rateLimitGroupWhitelabel, func() (interface{}, error) {
+		return r.client.CreateBrandedLink(ctx, input)
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Creating branded link",
-			fmt.Sprintf("Unable to create branded link, got error: %s", err),
+			fmt.Sprintf("Unable to create branded link, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -217,6 +239,7 @@ func (r *linkBrandingResource) Create(ctx context.Context, req resource.CreateRe
 	data.Legacy = types.BoolValue(o.Legacy)
 	data.Valid = types.BoolValue(o.Valid)
 	data.DNS = convertDNSBrandedLinkToSetType(o.DNS)
+	resp.Diagnostics.Append(checkBrandedLinkDNSRecords(o.DNS)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -231,13 +254,23 @@ func (r *linkBrandingResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "read", data.ID)
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	id := data.ID.ValueString()
 	linkId, _ := strconv.ParseInt(id, 10, 64)
 	o, err := r.client.GetBrandedLink(ctx, linkId)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading branded link",
-			fmt.Sprintf("Unable to get branded link, got error: %s", err),
+			fmt.Sprintf("Unable to get branded link, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -250,6 +283,7 @@ func (r *linkBrandingResource) Read(ctx context.Context, req resource.ReadReques
 	data.Legacy = types.BoolValue(o.Legacy)
 	data.Valid = types.BoolValue(o.Valid)
 	data.DNS = convertDNSBrandedLinkToSetType(o.DNS)
+	resp.Diagnostics.Append(checkBrandedLinkDNSRecords(o.DNS)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -258,11 +292,22 @@ func (r *linkBrandingResource) Read(ctx context.Context, req resource.ReadReques
 }
 
 func (r *linkBrandingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data linkBrandingResourceModel
+	var data, state linkBrandingResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAPILogFields(ctx, "update", data.ID)
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
 
 	id := data.ID.ValueString()
 	domainId, _ := strconv.ParseInt(id, 10, 64)
@@ -273,20 +318,30 @@ func (r *linkBrandingResource) Update(ctx context.Context, req resource.UpdateRe
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Updating branded link",
-			fmt.Sprintf("Unable to update branded link, got error: %s", err),
+			fmt.Sprintf("Unable to update branded link, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
 
+	// NOTE: SendGrid keeps exactly one default link branding and moves the flag
+	//       only when another link branding is made the default.
+	//       When this link branding hands the default off, the response may still report it as the default
+	//       until the link branding taking over is applied, so the planned value is kept in the tfstate.
+	def := o.Default
+	if state.Default.ValueBool() && !data.Default.ValueBool() {
+		def = false
+	}
+
 	data.ID = types.StringValue(strconv.FormatInt(o.ID, 10))
 	data.UserID = types.Int64Value(o.UserID)
 	data.Subdomain = types.StringValue(o.Subdomain)
 	data.Domain = types.StringValue(o.Domain)
 	data.Username = types.StringValue(o.Username)
-	data.Default = types.BoolValue(o.Default)
+	data.Default = types.BoolValue(def)
 	data.Legacy = types.BoolValue(o.Legacy)
 	data.Valid = types.BoolValue(o.Valid)
 	data.DNS = convertDNSBrandedLinkToSetType(o.DNS)
+	resp.Diagnostics.Append(checkBrandedLinkDNSRecords(o.DNS)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -301,21 +356,33 @@ func (r *linkBrandingResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "delete", data.ID)
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	linkId := data.ID.ValueString()
 	id, _ := strconv.ParseInt(linkId, 10, 64)
-	_, err := retryOnRateLimit(ctx, func() (interface{}, error) {
+	_, err := r.rateLimits.retry(ctx, rateLimitGroupWhitelabel, func() (interface{}, error) {
 		return nil, r.client.DeleteBrandedLink(ctx, id)
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Deleting branded link",
-			fmt.Sprintf("Unable to delete branded link (id: %s), got error: %s", linkId, err),
+			fmt.Sprintf("Unable to delete branded link (id: %s), got error: %s", linkId, sendgridErrorDetail(err)),
 		)
 		return
 	}
 }
 
 func (r *linkBrandingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = withAPILogFields(ctx, "import", types.StringValue(req.ID))
+
 	var data linkBrandingResourceModel
 
 	linkId := req.ID
@@ -326,7 +393,7 @@ func (r *linkBrandingResource) ImportState(ctx context.Context, req resource.Imp
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Importing branded link",
-			fmt.Sprintf("Unable to parse int (id: %s), got error: %s", linkId, err),
+			fmt.Sprintf("Unable to parse int (id: %s), got error: %s", linkId, sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -335,7 +402,7 @@ func (r *linkBrandingResource) ImportState(ctx context.Context, req resource.Imp
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Importing branded link",
-			fmt.Sprintf("Unable to get branded link (id: %s), got error: %s", linkId, err),
+			fmt.Sprintf("Unable to get branded link (id: %s), got error: %s", linkId, sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -349,6 +416,8 @@ func (r *linkBrandingResource) ImportState(ctx context.Context, req resource.Imp
 	data.Legacy = types.BoolValue(o.Legacy)
 	data.Valid = types.BoolValue(o.Valid)
 	data.DNS = convertDNSBrandedLinkToSetType(o.DNS)
+	data.Timeouts = nullTimeouts()
+	resp.Diagnostics.Append(checkBrandedLinkDNSRecords(o.DNS)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -356,6 +425,19 @@ func (r *linkBrandingResource) ImportState(ctx context.Context, req resource.Imp
 	}
 }
 
+// checkBrandedLinkDNSRecords warns when SendGrid did not return every DNS record of a link branding,
+// so that a partial dns block is not silently stored.
+func checkBrandedLinkDNSRecords(dns sendgrid.DNSBrandedLink) diag.Diagnostics {
+	var missing []string
+	if dns.DomainCname.Type == "" {
+		missing = append(missing, "domain_cname")
+	}
+	if dns.OwnerCname.Type == "" {
+		missing = append(missing, "owner_cname")
+	}
+	return incompleteDNSRecordsDiagnostics("link branding", missing)
+}
+
 func convertDNSBrandedLinkToSetType(dns sendgrid.DNSBrandedLink) (recordsSet basetypes.SetValue) {
 	var records []attr.Value
 
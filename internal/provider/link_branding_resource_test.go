@@ -4,11 +4,18 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"strings"
 	"testing"
 
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/i10416/sendgrid"
 )
 
 func TestAccLinkBrandingResource(t *testing.T) {
@@ -52,6 +59,114 @@ func TestAccLinkBrandingResource(t *testing.T) {
 	})
 }
 
+func TestAccLinkBrandingResourceDefaultHandoff(t *testing.T) {
+	resourceNameA := "sendgrid_link_branding.a"
+	resourceNameB := "sendgrid_link_branding.b"
+
+	domainA := fmt.Sprintf("test-acc-%s.com", acctest.RandString(16))
+	domainB := fmt.Sprintf("test-acc-%s.com", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with a as the default link branding
+			{
+				Config: testAccLinkBrandingResourceDefaultHandoffConfig(domainA, true, domainB, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameA, "default", "true"),
+					resource.TestCheckResourceAttr(resourceNameB, "default", "false"),
+				),
+			},
+			// Hand the default off to b; the refreshed plan must be empty for both
+			{
+				Config: testAccLinkBrandingResourceDefaultHandoffConfig(domainA, false, domainB, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameA, "default", "false"),
+					resource.TestCheckResourceAttr(resourceNameB, "default", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestCheckBrandedLinkDNSRecords(t *testing.T) {
+	var dns sendgrid.DNSBrandedLink
+	dns.DomainCname.Type = "cname"
+	dns.OwnerCname.Type = "cname"
+	if diags := checkBrandedLinkDNSRecords(dns); len(diags) != 0 {
+		t.Errorf("unexpected diagnostics for complete records: %v", diags)
+	}
+
+	// owner_cname is missing from the response.
+	dns.OwnerCname.Type = ""
+	diags := checkBrandedLinkDNSRecords(dns)
+	if diags.WarningsCount() != 1 || diags.HasError() {
+		t.Fatalf("got %v, want a single warning", diags)
+	}
+	if detail := diags[0].Detail(); !strings.Contains(detail, "owner_cname") {
+		t.Errorf("got detail %q, want it to name owner_cname", detail)
+	}
+}
+
+func TestLinkBrandingResourceImportState(t *testing.T) {
+	ctx := context.Background()
+	rt := newMockTransport()
+	rt.Handle(http.MethodGet, "/v3/whitelabel/links/1", func(_ *http.Request) (int, any) {
+		return http.StatusOK, map[string]any{
+			"id":        1,
+			"domain":    "example.com",
+			"subdomain": "url1234",
+			"username":  "john",
+			"user_id":   7,
+			"default":   false,
+			"valid":     true,
+			"legacy":    false,
+			"dns": map[string]any{
+				"domain_cname": map[string]any{"valid": true, "type": "cname", "host": "url1234.example.com", "data": "sendgrid.net"},
+				"owner_cname":  map[string]any{"valid": true, "type": "cname", "host": "7.example.com", "data": "sendgrid.net"},
+			},
+		}
+	})
+	r := &linkBrandingResource{client: newMockClient(rt)}
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	s := schemaResp.Schema
+
+	resp := &fwresource.ImportStateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+	r.ImportState(ctx, fwresource.ImportStateRequest{ID: "1"}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var got linkBrandingResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if got.ID.ValueString() != "1" || got.Domain.ValueString() != "example.com" {
+		t.Errorf("got id %s and domain %s, want the imported link branding", got.ID, got.Domain)
+	}
+	if !got.Timeouts.IsNull() {
+		t.Errorf("got timeouts %s, want null", got.Timeouts)
+	}
+}
+
+func testAccLinkBrandingResourceDefaultHandoffConfig(domainA string, defA bool, domainB string, defB bool) string {
+	return fmt.Sprintf(`
+resource "sendgrid_link_branding" "a" {
+  domain = "%s"
+  default = %t
+}
+
+resource "sendgrid_link_branding" "b" {
+  domain = "%s"
+  default = %t
+}
+`, domainA, defA, domainB, defB)
+}
+
 func testAccLinkBrandingResourceConfig(domain string, def bool) string {
 	return fmt.Sprintf(`
 resource "sendgrid_link_branding" "test" {
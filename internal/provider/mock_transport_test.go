@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/i10416/sendgrid"
+)
+
+// newMockClient returns a SendGrid client sending its requests through rt.
+// Like the client of the provider, it keeps the status code of 404 responses for isNotFoundError.
+func newMockClient(rt http.RoundTripper) *sendgrid.Client {
+	return sendgrid.New("SG.test", sendgrid.OptionHTTPClient(&http.Client{Transport: notFoundStatusTransport{base: rt}}))
+}
+
+// mockHandler returns the status code and the body, encoded as JSON unless nil, for a request.
+type mockHandler func(req *http.Request) (int, any)
+
+// mockTransport is an http.RoundTripper that serves registered handlers instead of the SendGrid API.
+// Requests without a handler get a 404 response.
+type mockTransport struct {
+	mu       sync.Mutex
+	handlers map[string]mockHandler
+	requests []*http.Request
+}
+
+func newMockTransport() *mockTransport {
+	return &mockTransport{
+		handlers: map[string]mockHandler{},
+	}
+}
+
+// Handle registers h for requests with the given method and URL path.
+func (m *mockTransport) Handle(method, path string, h mockHandler) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.handlers[method+" "+path] = h
+}
+
+// Requests returns the requests received so far.
+func (m *mockTransport) Requests() []*http.Request {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*http.Request(nil), m.requests...)
+}
+
+func (m *mockTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	m.mu.Lock()
+	m.requests = append(m.requests, req)
+	h, ok := m.handlers[req.Method+" "+req.URL.Path]
+	m.mu.Unlock()
+
+	status, body := http.StatusNotFound, any(map[string]any{
+		"errors": []map[string]any{{"message": "resource not found"}},
+	})
+	if ok {
+		status, body = h(req)
+	}
+
+	var b []byte
+	if body != nil {
+		var err error
+		b, err = json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &http.Response{
+		StatusCode: status,
+		Status:     http.StatusText(status),
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(bytes.NewReader(b)),
+		Request:    req,
+	}, nil
+}
@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+)
+
+// paginateAll collects every item from an offset-based paginated endpoint.
+// fetch is called with an increasing offset until it returns fewer items than limit.
+// limit must be positive, since the offset would never advance otherwise.
+func paginateAll[T any](ctx context.Context, limit int, fetch func(ctx context.Context, limit, offset int) ([]T, error)) ([]T, error) {
+	if limit <= 0 {
+		return nil, fmt.Errorf("page size must be positive, got: %d", limit)
+	}
+
+	var items []T
+
+	for offset := 0; ; offset += limit {
+		page, err := fetch(ctx, limit, offset)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, page...)
+
+		if len(page) < limit {
+			return items, nil
+		}
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestPaginateAll(t *testing.T) {
+	items := []int{}
+	for i := 0; i < 7; i++ {
+		items = append(items, i)
+	}
+
+	var offsets []int
+	got, err := paginateAll(context.Background(), 3, func(_ context.Context, limit, offset int) ([]int, error) {
+		offsets = append(offsets, offset)
+		end := min(offset+limit, len(items))
+		return items[offset:end], nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !slices.Equal(got, items) {
+		t.Errorf("got %v, want %v", got, items)
+	}
+	if want := []int{0, 3, 6}; !slices.Equal(offsets, want) {
+		t.Errorf("got offsets %v, want %v", offsets, want)
+	}
+}
+
+func TestPaginateAllExactMultipleOfLimit(t *testing.T) {
+	calls := 0
+	got, err := paginateAll(context.Background(), 2, func(_ context.Context, limit, offset int) ([]string, error) {
+		calls++
+		if offset >= 4 {
+			return nil, nil
+		}
+		return []string{"a", "b"}, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(got) != 4 {
+		t.Errorf("got %d items, want 4", len(got))
+	}
+	if calls != 3 {
+		t.Errorf("got %d calls, want 3", calls)
+	}
+}
+
+func TestPaginateAllError(t *testing.T) {
+	want := errors.New("boom")
+	_, err := paginateAll(context.Background(), 2, func(_ context.Context, limit, offset int) ([]string, error) {
+		if offset > 0 {
+			return nil, want
+		}
+		return []string{"a", "b"}, nil
+	})
+	if !errors.Is(err, want) {
+		t.Errorf("got error %v, want %v", err, want)
+	}
+}
+
+func TestPaginateAllRejectsNonPositiveLimit(t *testing.T) {
+	for _, limit := range []int{0, -1} {
+		calls := 0
+		_, err := paginateAll(context.Background(), limit, func(_ context.Context, limit, offset int) ([]string, error) {
+			calls++
+			return []string{}, nil
+		})
+		if err == nil {
+			t.Errorf("limit %d: got no error, want the limit to be rejected", limit)
+		}
+		if calls != 0 {
+			t.Errorf("limit %d: got %d calls, want none", limit, calls)
+		}
+	}
+}
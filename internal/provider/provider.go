@@ -5,16 +5,21 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/url"
 	"os"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/i10416/sendgrid"
 )
 
@@ -27,12 +32,24 @@ type sendgridProvider struct {
 	// provider is built and ran locally, and "test" when running acceptance
 	// testing.
 	version string
+
+	// httpClient overrides the HTTP client used by the SendGrid client.
+	// It is only set by tests to run the provider against a mock transport.
+	httpClient *http.Client
+}
+
+// providerData is passed to the Configure method of every resource and data source.
+type providerData struct {
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
 }
 
 // sendgridProviderModel describes the provider data model.
 type sendgridProviderModel struct {
-	APIKey  types.String `tfsdk:"api_key"`
-	Subuser types.String `tfsdk:"subuser"`
+	APIKey       types.String `tfsdk:"api_key"`
+	Subuser      types.String `tfsdk:"subuser"`
+	MaxRetries   types.Int64  `tfsdk:"max_retries"`
+	RetryMaxWait types.String `tfsdk:"retry_max_wait"`
 }
 
 func (p *sendgridProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -49,8 +66,22 @@ func (p *sendgridProvider) Schema(ctx context.Context, req provider.SchemaReques
 				Sensitive:           true,
 			},
 			"subuser": schema.StringAttribute{
-				MarkdownDescription: "Subuser for Sendgrid API. May also be provided via SENDGRID_SUBUSER environment variable.",
+				MarkdownDescription: "Subuser for Sendgrid API. When set, every request is sent with the `on-behalf-of` header so that a parent account manages the subuser's resources. May also be provided via SENDGRID_SUBUSER environment variable.",
+				Optional:            true,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The maximum number of times a request is retried after a rate limit, a 5xx response or a network error. Requests creating a resource are only retried after a rate limit, since they may have been processed. Defaults to %d.", defaultMaxRetries),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_max_wait": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The maximum total time a request waits for rate limits to reset and between retries before giving up, as a duration such as `30s` or `2m`. Defaults to `%s`.", defaultRetryMaxWait),
 				Optional:            true,
+				Validators: []validator.String{
+					stringPositiveDuration(),
+				},
 			},
 		},
 	}
@@ -60,6 +91,9 @@ func (p *sendgridProvider) Configure(ctx context.Context, req provider.Configure
 	// Check environment variables
 	apiKey := os.Getenv("SENDGRID_API_KEY")
 	subuser := os.Getenv("SENDGRID_SUBUSER")
+	// NOTE: SENDGRID_BASE_URL is intentionally undocumented. It points the client at another API endpoint,
+	//       such as an httptest.Server, so that tests can run the provider without a SendGrid account.
+	baseURL := os.Getenv("SENDGRID_BASE_URL")
 
 	// Retrieve provider data from configuration
 	var config sendgridProviderModel
@@ -102,21 +136,64 @@ func (p *sendgridProvider) Configure(ctx context.Context, req provider.Configure
 		)
 	}
 
+	maxRetries := defaultMaxRetries
+	if !config.MaxRetries.IsNull() && !config.MaxRetries.IsUnknown() {
+		maxRetries = int(config.MaxRetries.ValueInt64())
+	}
+
+	retryMaxWait := defaultRetryMaxWait
+	if !config.RetryMaxWait.IsNull() && !config.RetryMaxWait.IsUnknown() {
+		// NOTE: retry_max_wait is validated by its schema validator to be a positive duration.
+		if d, err := time.ParseDuration(config.RetryMaxWait.ValueString()); err == nil {
+			retryMaxWait = d
+		}
+	}
+
+	if baseURL != "" {
+		baseURL = strings.TrimSuffix(baseURL, "/")
+		u, err := url.Parse(baseURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			resp.Diagnostics.AddError(
+				"Invalid SendGrid base URL",
+				fmt.Sprintf("SENDGRID_BASE_URL must be an absolute http or https URL such as https://api.sendgrid.com/v3, got: %q.", baseURL),
+			)
+		}
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var client *sendgrid.Client
+	// NOTE: The rate limit buckets are shared by every resource of this provider configuration, so the retry
+	//       settings apply provider-wide while provider aliases, which may use other accounts, are independent.
+	rateLimits := newRateLimitBuckets()
+	rateLimits.configure(maxRetries, retryMaxWait)
+
+	var opts []sendgrid.Option
 	if subuser != "" {
-		client = sendgrid.New(apiKey, sendgrid.OptionSubuser(subuser))
-	} else {
-		client = sendgrid.New(apiKey)
+		opts = append(opts, sendgrid.OptionSubuser(subuser))
+	}
+	if baseURL != "" {
+		opts = append(opts, sendgrid.OptionBaseURL(baseURL))
+	}
+	httpClient := &http.Client{}
+	if p.httpClient != nil {
+		c := *p.httpClient
+		httpClient = &c
 	}
+	base := httpClient.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	httpClient.Transport = apiLogTransport{base: rateLimitResetTransport{base: notFoundStatusTransport{base: base}}}
+	opts = append(opts, sendgrid.OptionHTTPClient(httpClient))
+	client := sendgrid.New(apiKey, opts...)
 
-	// Make the SendGrid api key available during DataSource and Resource
+	// Make the SendGrid client and the rate limit buckets available during DataSource and Resource
 	// type Configure methods.
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	data := &providerData{client: client, rateLimits: rateLimits}
+	resp.DataSourceData = data
+	resp.ResourceData = data
 }
 
 func (p *sendgridProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -141,12 +218,25 @@ func (p *sendgridProvider) Resources(ctx context.Context) []func() resource.Reso
 		newAlertResource,
 		newCustomFieldResource,
 		newAllowlistRuleResource,
+		newAllowlistRulesResource,
+		newIPPoolResource,
+		newIPPoolAssignmentResource,
+		newIPWarmupResource,
+		newUsageNotificationResource,
+		newBounceResource,
+		newGlobalUnsubscribeResource,
+		newGlobalSuppressionResource,
+		newAddressAllowlistSettingsResource,
+		newForwardBounceSettingsResource,
+		newSpamCheckSettingsResource,
 	}
 }
 
 func (p *sendgridProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		newTeammateDataSource,
+		newTeammatesDataSource,
+		newTeammateInviteDataSource,
 		newAPIKeyDataSource,
 		newSubuserDataSource,
 		newSenderAuthenticationDataSource,
@@ -163,6 +253,12 @@ func (p *sendgridProvider) DataSources(ctx context.Context) []func() datasource.
 		newInboundParseWebhookDataSource,
 		newClickTrackingSettingsDataSource,
 		newAlertDataSource,
+		newCustomFieldDataSource,
+		newAllowlistRulesDataSource,
+		newBouncesDataSource,
+		newStatsDataSource,
+		newCategoriesDataSource,
+		newAccountScopesDataSource,
 	}
 }
 
@@ -173,47 +269,3 @@ func New(version string) func() provider.Provider {
 		}
 	}
 }
-
-func retryOnRateLimit(ctx context.Context, f func() (interface{}, error)) (resp interface{}, err error) {
-	maxRetries := 5
-	baseDelay := 1 * time.Second
-	maxDelay := 60 * time.Second
-
-	for retry := 0; retry < maxRetries; retry++ {
-		resp, err = f()
-		if err == nil {
-			return resp, nil
-		}
-
-		if rle, ok := err.(*sendgrid.RateLimitedError); ok {
-			var waitTime time.Duration
-			if rle.RetryAfter > 0 {
-				waitTime = rle.RetryAfter
-				waitTime += time.Duration(retry*100) * time.Millisecond
-			} else {
-				waitTime = baseDelay * (1 << uint(retry))
-			}
-
-			if waitTime > maxDelay {
-				waitTime = maxDelay
-			}
-
-			tflog.Info(ctx, "Rate limited, retrying", map[string]interface{}{
-				"retry_attempt": retry + 1,
-				"max_retries":   maxRetries,
-				"wait_seconds":  waitTime.Seconds(),
-			})
-
-			select {
-			case <-ctx.Done():
-				return nil, ctx.Err()
-			case <-time.After(waitTime):
-				continue
-			}
-		}
-
-		return resp, err
-	}
-
-	return resp, err
-}
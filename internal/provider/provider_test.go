@@ -4,11 +4,24 @@
 package provider
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"regexp"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -19,6 +32,17 @@ var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 	"sendgrid": providerserver.NewProtocol6WithError(New("test")()),
 }
 
+// testProtoV6ProviderFactoriesWithTransport instantiates the provider with its HTTP requests
+// sent through rt, so that tests can exercise resources against a mock SendGrid API.
+func testProtoV6ProviderFactoriesWithTransport(rt http.RoundTripper) map[string]func() (tfprotov6.ProviderServer, error) {
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"sendgrid": providerserver.NewProtocol6WithError(&sendgridProvider{
+			version:    "test",
+			httpClient: &http.Client{Transport: rt},
+		}),
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	t.Helper()
 
@@ -29,3 +53,219 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("IP_ADDRESS must be set for acceptance tests")
 	}
 }
+
+func TestProviderConfigureRetrySettings(t *testing.T) {
+	rt, _ := newMockCustomFieldTransport()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactoriesWithTransport(rt),
+		Steps: []resource.TestStep{
+			{
+				Config:      testProviderRetrySettingsConfig(2, "soon"),
+				ExpectError: regexp.MustCompile(`Value must be a positive duration`),
+			},
+			{
+				Config:      testProviderRetrySettingsConfig(-1, "30s"),
+				ExpectError: regexp.MustCompile(`at least 0`),
+			},
+		},
+	})
+}
+
+// testConfigureProvider configures a provider with the given max_retries and retry_max_wait and
+// returns the data it passes to resources.
+func testConfigureProvider(t *testing.T, maxRetries int64, retryMaxWait string) *providerData {
+	t.Helper()
+	ctx := context.Background()
+
+	p := &sendgridProvider{version: "test"}
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+	s := schemaResp.Schema
+
+	config := tfsdk.Config{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+		"api_key":        tftypes.NewValue(tftypes.String, "SG.test"),
+		"subuser":        tftypes.NewValue(tftypes.String, nil),
+		"max_retries":    tftypes.NewValue(tftypes.Number, maxRetries),
+		"retry_max_wait": tftypes.NewValue(tftypes.String, retryMaxWait),
+	})}
+	resp := &provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{Config: config}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	data, ok := resp.ResourceData.(*providerData)
+	if !ok || resp.DataSourceData != resp.ResourceData {
+		t.Fatalf("got resource data %T and data source data %T, want the same *providerData", resp.ResourceData, resp.DataSourceData)
+	}
+	return data
+}
+
+func TestProviderConfigureRateLimitsPerProvider(t *testing.T) {
+	t.Setenv("SENDGRID_SUBUSER", "")
+	t.Setenv("SENDGRID_BASE_URL", "")
+
+	first := testConfigureProvider(t, 2, "30s")
+	second := testConfigureProvider(t, 5, "1m")
+
+	if maxRetries, maxWait := first.rateLimits.settings(); maxRetries != 2 || maxWait != 30*time.Second {
+		t.Errorf("got retry settings (%d, %s), want (2, 30s)", maxRetries, maxWait)
+	}
+	// Provider aliases may use other accounts, so they neither share rate limits nor retry settings.
+	if first.rateLimits == second.rateLimits {
+		t.Fatal("got the same rate limit buckets for two provider configurations")
+	}
+	if maxRetries, maxWait := second.rateLimits.settings(); maxRetries != 5 || maxWait != time.Minute {
+		t.Errorf("got retry settings (%d, %s), want (5, 1m0s)", maxRetries, maxWait)
+	}
+}
+
+func testProviderRetrySettingsConfig(maxRetries int, retryMaxWait string) string {
+	return fmt.Sprintf(`
+provider "sendgrid" {
+	api_key        = "SG.test"
+	max_retries    = %[1]d
+	retry_max_wait = "%[2]s"
+}
+
+resource "sendgrid_custom_field" "test" {
+	name = "favorite_color"
+	type = "text"
+}
+`, maxRetries, retryMaxWait)
+}
+
+func TestProviderConfigureSubuserHeader(t *testing.T) {
+	t.Setenv("SENDGRID_SUBUSER", "")
+
+	cases := []struct {
+		name    string
+		subuser string
+	}{
+		{name: "subuser", subuser: "example-subuser"},
+		{name: "parent account"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rt, _ := newMockCustomFieldTransport()
+
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testProtoV6ProviderFactoriesWithTransport(rt),
+				Steps: []resource.TestStep{
+					{
+						Config: testProviderSubuserConfig(c.subuser),
+						Check: func(_ *terraform.State) error {
+							reqs := rt.Requests()
+							if len(reqs) == 0 {
+								return fmt.Errorf("expected requests to be sent")
+							}
+							for _, req := range reqs {
+								got, ok := req.Header["On-Behalf-Of"]
+								if c.subuser == "" && ok {
+									return fmt.Errorf("%s %s: got On-Behalf-Of %q, want it omitted", req.Method, req.URL.Path, got)
+								}
+								if c.subuser != "" && req.Header.Get("On-Behalf-Of") != c.subuser {
+									return fmt.Errorf("%s %s: got On-Behalf-Of %q, want %q", req.Method, req.URL.Path, got, c.subuser)
+								}
+							}
+							return nil
+						},
+					},
+				},
+			})
+		})
+	}
+}
+
+func testProviderSubuserConfig(subuser string) string {
+	setting := ""
+	if subuser != "" {
+		setting = fmt.Sprintf("subuser = %q", subuser)
+	}
+	return fmt.Sprintf(`
+provider "sendgrid" {
+	api_key = "SG.test"
+	%s
+}
+
+resource "sendgrid_custom_field" "test" {
+	name = "favorite_color"
+	type = "text"
+}
+`, setting)
+}
+
+func TestProviderBaseURL(t *testing.T) {
+	resourceName := "sendgrid_custom_field.test"
+
+	rt, fields := newMockCustomFieldTransport()
+	srv := httptest.NewServer(mockTransportHandler(rt))
+	t.Cleanup(srv.Close)
+	t.Setenv("SENDGRID_BASE_URL", srv.URL+"/v3")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			if fields.len() != 0 {
+				return errors.New("custom field still exists after destroy")
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testCustomFieldResourceMockConfig("favorite_color", "text"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name", "favorite_color"),
+					func(_ *terraform.State) error {
+						if fields.len() != 1 {
+							return fmt.Errorf("got %d custom fields on the mock server, want 1", fields.len())
+						}
+						return nil
+					},
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestProviderInvalidBaseURL(t *testing.T) {
+	t.Setenv("SENDGRID_BASE_URL", "api.sendgrid.com/v3")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testCustomFieldResourceMockConfig("favorite_color", "text"),
+				ExpectError: regexp.MustCompile(`SENDGRID_BASE_URL must be an absolute http or https URL`),
+			},
+		},
+	})
+}
+
+// mockTransportHandler serves requests to an httptest.Server from rt.
+func mockTransportHandler(rt http.RoundTripper) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		res, err := rt.RoundTrip(req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		defer res.Body.Close()
+
+		for k, v := range res.Header {
+			w.Header()[k] = v
+		}
+		w.WriteHeader(res.StatusCode)
+		_, _ = io.Copy(w, res.Body)
+	})
+}
@@ -0,0 +1,315 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/i10416/sendgrid"
+)
+
+// rateLimitGroup is a group of SendGrid endpoints sharing a rate limit bucket.
+type rateLimitGroup string
+
+const (
+	rateLimitGroupAccessSettings  rateLimitGroup = "access_settings"
+	rateLimitGroupAlerts          rateLimitGroup = "alerts"
+	rateLimitGroupAPIKeys         rateLimitGroup = "api_keys"
+	rateLimitGroupASM             rateLimitGroup = "asm"
+	rateLimitGroupCategories      rateLimitGroup = "categories"
+	rateLimitGroupContactDB       rateLimitGroup = "contactdb"
+	rateLimitGroupEventWebhook    rateLimitGroup = "user/webhooks/event"
+	rateLimitGroupInboundParse    rateLimitGroup = "user/webhooks/parse"
+	rateLimitGroupIPs             rateLimitGroup = "ips"
+	rateLimitGroupMailSettings    rateLimitGroup = "mail_settings"
+	rateLimitGroupScopes          rateLimitGroup = "scopes"
+	rateLimitGroupSSO             rateLimitGroup = "sso"
+	rateLimitGroupStats           rateLimitGroup = "stats"
+	rateLimitGroupSubusers        rateLimitGroup = "subusers"
+	rateLimitGroupSuppression     rateLimitGroup = "suppression"
+	rateLimitGroupTeammates       rateLimitGroup = "teammates"
+	rateLimitGroupTemplates       rateLimitGroup = "templates"
+	rateLimitGroupVerifiedSenders rateLimitGroup = "verified_senders"
+	rateLimitGroupWhitelabel      rateLimitGroup = "whitelabel"
+)
+
+const (
+	// defaultMaxRetries is the number of times a rate limited request is retried unless configured otherwise.
+	defaultMaxRetries = 4
+	// defaultRetryMaxWait is the total time spent waiting for rate limits to reset unless configured otherwise.
+	defaultRetryMaxWait = 5 * time.Minute
+
+	// rateLimitBaseDelay is the first wait of the exponential backoff used when the reset time is unknown.
+	rateLimitBaseDelay = 1 * time.Second
+	// rateLimitMaxDelay caps a single wait of the exponential backoff.
+	rateLimitMaxDelay = 60 * time.Second
+)
+
+// rateLimitBuckets tracks when the rate limit of each endpoint group resets.
+// Each configured provider has its own buckets, since provider aliases may use different accounts.
+type rateLimitBuckets struct {
+	mu     sync.Mutex
+	resets map[rateLimitGroup]time.Time
+	// maxRetries is the number of retries after the first attempt of a rate limited request.
+	maxRetries int
+	// maxWait bounds the total time a request waits for rate limits to reset.
+	maxWait time.Duration
+	// backoffBaseDelay is the first wait of the exponential backoff used to retry transient errors.
+	backoffBaseDelay time.Duration
+}
+
+func newRateLimitBuckets() *rateLimitBuckets {
+	return &rateLimitBuckets{
+		resets:           map[rateLimitGroup]time.Time{},
+		maxRetries:       defaultMaxRetries,
+		maxWait:          defaultRetryMaxWait,
+		backoffBaseDelay: rateLimitBaseDelay,
+	}
+}
+
+// configure sets how many times and for how long rate limited requests are retried.
+func (b *rateLimitBuckets) configure(maxRetries int, maxWait time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.maxRetries = maxRetries
+	b.maxWait = maxWait
+}
+
+func (b *rateLimitBuckets) settings() (int, time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.maxRetries, b.maxWait
+}
+
+// limit records that group is rate limited for d.
+func (b *rateLimitBuckets) limit(group rateLimitGroup, d time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	reset := time.Now().Add(d)
+	if reset.After(b.resets[group]) {
+		b.resets[group] = reset
+	}
+}
+
+// wait blocks until the rate limit of group resets or ctx is done.
+func (b *rateLimitBuckets) wait(ctx context.Context, group rateLimitGroup) error {
+	b.mu.Lock()
+	d := time.Until(b.resets[group])
+	b.mu.Unlock()
+
+	if d <= 0 {
+		return nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(d):
+		return nil
+	}
+}
+
+// retryClass tells how retry handles an error returned by a SendGrid API call.
+type retryClass int
+
+const (
+	// retryNever is an error that fails the same way when retried, such as a 4xx response.
+	retryNever retryClass = iota
+	// retryRateLimited is a 429 response, retried once the rate limit of the endpoint group resets.
+	retryRateLimited
+	// retryTransient is a 5xx response or a network error, retried with an exponential backoff.
+	retryTransient
+)
+
+// classifyRetry returns how retry handles err.
+// The client only keeps the status code of a response without an error body, so a 5xx response with
+// an error body is not recognized as transient and is returned immediately like any other error.
+func classifyRetry(err error) retryClass {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return retryNever
+	}
+
+	var rle *sendgrid.RateLimitedError
+	if errors.As(err, &rle) {
+		return retryRateLimited
+	}
+
+	var sc interface{ HTTPStatusCode() int }
+	if errors.As(err, &sc) {
+		switch sc.HTTPStatusCode() {
+		case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return retryTransient
+		}
+		return retryNever
+	}
+
+	var ne net.Error
+	if errors.As(err, &ne) {
+		return retryTransient
+	}
+
+	return retryNever
+}
+
+// retry calls f, calling it again while it fails with an error classifyRetry considers retryable.
+// A rate limited call waits for the rate limit of group to reset and a transient error waits for
+// an exponential backoff, while any other error is returned immediately. It gives up and returns
+// the last error once f was retried maxRetries times, or when the next wait would pass the deadline
+// of ctx or the end of maxWait. f must be idempotent, such as a GET, PUT or DELETE request, because
+// a request failing with a transient error may have been processed.
+func (b *rateLimitBuckets) retry(ctx context.Context, group rateLimitGroup, f func() (interface{}, error)) (resp interface{}, err error) {
+	return b.retryIf(ctx, group, true, f)
+}
+
+// retryNonIdempotent is retry for a request that must not be sent twice, such as a POST creating a resource.
+// It only retries rate limited calls, which SendGrid rejects without processing them, and returns a transient
+// error immediately.
+func (b *rateLimitBuckets) retryNonIdempotent(ctx context.Context, group rateLimitGroup, f func() (interface{}, error)) (resp interface{}, err error) {
+	return b.retryIf(ctx, group, false, f)
+}
+
+// retryIf implements retry, retrying transient errors only when idempotent is true.
+// A nil b retries with the default settings, without sharing rate limits with other calls.
+func (b *rateLimitBuckets) retryIf(ctx context.Context, group rateLimitGroup, idempotent bool, f func() (interface{}, error)) (resp interface{}, err error) {
+	if b == nil {
+		b = newRateLimitBuckets()
+	}
+	ctx = apiLogContext(ctx)
+	maxRetries, maxWait := b.settings()
+
+	deadline := time.Now().Add(maxWait)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+
+	for retry := 0; retry <= maxRetries; retry++ {
+		if retry > 0 && b.resetAfter(group, deadline) {
+			tflog.Warn(ctx, "Rate limited, giving up as the rate limit resets after the retry deadline", map[string]interface{}{
+				"rate_limit_group": string(group),
+				"retry_attempt":    retry,
+			})
+			return resp, err
+		}
+
+		if err := b.wait(ctx, group); err != nil {
+			return nil, err
+		}
+
+		tflog.Trace(ctx, "Calling SendGrid API", map[string]interface{}{
+			"rate_limit_group": string(group),
+			"retry_attempt":    retry,
+		})
+		resp, err = f()
+		if err == nil {
+			return resp, nil
+		}
+
+		switch classifyRetry(err) {
+		case retryRateLimited:
+			var rle *sendgrid.RateLimitedError
+			errors.As(err, &rle)
+			waitTime := rateLimitWaitTime(rle, retry, maxWait)
+
+			tflog.Info(ctx, "Rate limited, retrying", map[string]interface{}{
+				"rate_limit_group": string(group),
+				"retry_attempt":    retry + 1,
+				"max_retries":      maxRetries,
+				"wait_seconds":     waitTime.Seconds(),
+			})
+
+			b.limit(group, waitTime)
+			continue
+		case retryTransient:
+			waitTime := min(b.backoffBaseDelay*(1<<uint(retry)), rateLimitMaxDelay, maxWait)
+			if !idempotent || retry == maxRetries || time.Now().Add(waitTime).After(deadline) {
+				break
+			}
+
+			tflog.Info(ctx, "Transient error, retrying", map[string]interface{}{
+				"rate_limit_group": string(group),
+				"retry_attempt":    retry + 1,
+				"max_retries":      maxRetries,
+				"wait_seconds":     waitTime.Seconds(),
+				"error":            err.Error(),
+			})
+
+			// NOTE: Unlike a rate limit, a transient error does not delay the other calls to group.
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(waitTime):
+			}
+			continue
+		}
+
+		return resp, err
+	}
+
+	tflog.Warn(ctx, "Rate limited, giving up after the maximum number of retries", map[string]interface{}{
+		"rate_limit_group": string(group),
+		"max_retries":      maxRetries,
+	})
+	return resp, err
+}
+
+// rateLimitWaitTime returns how long to wait before retrying a request that failed with rle.
+// It waits exactly until the rate limit resets, capped by maxWait, and falls back to
+// an exponential backoff when the reset time has already passed.
+func rateLimitWaitTime(rle *sendgrid.RateLimitedError, retry int, maxWait time.Duration) time.Duration {
+	if rle.RetryAfter > 0 {
+		return min(rle.RetryAfter, maxWait)
+	}
+	return min(rateLimitBaseDelay*(1<<uint(retry)), rateLimitMaxDelay, maxWait)
+}
+
+// rateLimitResetTransport makes the reset time of every 429 response available to the SendGrid client.
+// The client only reads the X-RateLimit-Reset header and fails to parse a 429 response without it,
+// so the header is filled in from Retry-After, or from a default wait when neither is set.
+type rateLimitResetTransport struct {
+	base http.RoundTripper
+}
+
+func (t rateLimitResetTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusTooManyRequests {
+		return resp, err
+	}
+	if _, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		return resp, nil
+	}
+
+	reset := rateLimitReset(resp.Header, time.Now())
+	// NOTE: X-RateLimit-Reset is in seconds, so round up not to retry before the reset.
+	resp.Header.Set("X-RateLimit-Reset", strconv.FormatInt(reset.Add(time.Second-time.Nanosecond).Unix(), 10))
+	return resp, nil
+}
+
+// rateLimitReset returns when the rate limit resets according to the Retry-After header,
+// given either in seconds or as an HTTP date, or after rateLimitBaseDelay when the header is absent.
+func rateLimitReset(h http.Header, now time.Time) time.Time {
+	if v := h.Get("Retry-After"); v != "" {
+		if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+			return now.Add(time.Duration(secs) * time.Second)
+		}
+		if t, err := http.ParseTime(v); err == nil {
+			return t
+		}
+	}
+	return now.Add(rateLimitBaseDelay)
+}
+
+// resetAfter reports whether the rate limit of group resets after deadline.
+func (b *rateLimitBuckets) resetAfter(group rateLimitGroup, deadline time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.resets[group].After(deadline)
+}
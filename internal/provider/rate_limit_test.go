@@ -0,0 +1,423 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/i10416/sendgrid"
+)
+
+func TestRateLimitBucketsIndependentGroups(t *testing.T) {
+	b := newRateLimitBuckets()
+
+	// The teammates endpoints are rate limited for a long time.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	teammateCalls := 0
+	_, err := b.retry(ctx, rateLimitGroupTeammates, func() (interface{}, error) {
+		teammateCalls++
+		return nil, &sendgrid.RateLimitedError{RetryAfter: time.Minute}
+	})
+	// The limit resets after the deadline of ctx, so the rate limit error is returned without waiting.
+	var rle *sendgrid.RateLimitedError
+	if !errors.As(err, &rle) {
+		t.Fatalf("got error %v, want a rate limit error", err)
+	}
+	if teammateCalls != 1 {
+		t.Errorf("got %d teammate calls, want 1", teammateCalls)
+	}
+
+	// Calls to another endpoint group are not delayed.
+	start := time.Now()
+	res, err := b.retry(context.Background(), rateLimitGroupAlerts, func() (interface{}, error) {
+		return "ok", nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if res != "ok" {
+		t.Errorf("got %v, want ok", res)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("alerts call was delayed by %s", elapsed)
+	}
+
+	// Further calls to the limited group wait for the reset instead of calling the endpoint.
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = b.retry(ctx, rateLimitGroupTeammates, func() (interface{}, error) {
+		teammateCalls++
+		return nil, nil
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got error %v, want %v", err, context.DeadlineExceeded)
+	}
+	if teammateCalls != 1 {
+		t.Errorf("got %d teammate calls, want 1", teammateCalls)
+	}
+}
+
+func TestRateLimitBucketsRetriesAfterReset(t *testing.T) {
+	b := newRateLimitBuckets()
+
+	calls := 0
+	res, err := b.retry(context.Background(), rateLimitGroupAlerts, func() (interface{}, error) {
+		calls++
+		if calls == 1 {
+			return nil, &sendgrid.RateLimitedError{RetryAfter: 10 * time.Millisecond}
+		}
+		return "ok", nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if res != "ok" || calls != 2 {
+		t.Errorf("got (%v, %d calls), want (ok, 2 calls)", res, calls)
+	}
+}
+
+func TestRateLimitBucketsNonRateLimitError(t *testing.T) {
+	b := newRateLimitBuckets()
+
+	want := errors.New("boom")
+	calls := 0
+	_, err := b.retry(context.Background(), rateLimitGroupAlerts, func() (interface{}, error) {
+		calls++
+		return nil, want
+	})
+	if !errors.Is(err, want) {
+		t.Errorf("got error %v, want %v", err, want)
+	}
+	if calls != 1 {
+		t.Errorf("got %d calls, want 1", calls)
+	}
+}
+
+func TestRateLimitBucketsGivesUpAfterMaxRetries(t *testing.T) {
+	for _, maxRetries := range []int{0, 1, 3} {
+		b := newRateLimitBuckets()
+		b.configure(maxRetries, time.Minute)
+
+		calls := 0
+		_, err := b.retry(context.Background(), rateLimitGroupAlerts, func() (interface{}, error) {
+			calls++
+			return nil, &sendgrid.RateLimitedError{RetryAfter: time.Millisecond}
+		})
+		var rle *sendgrid.RateLimitedError
+		if !errors.As(err, &rle) {
+			t.Errorf("max retries %d: got error %v, want a rate limit error", maxRetries, err)
+		}
+		if want := maxRetries + 1; calls != want {
+			t.Errorf("max retries %d: got %d calls, want %d", maxRetries, calls, want)
+		}
+	}
+}
+
+func TestRateLimitBucketsGivesUpAfterMaxWait(t *testing.T) {
+	b := newRateLimitBuckets()
+	b.configure(10, 50*time.Millisecond)
+
+	calls := 0
+	start := time.Now()
+	_, err := b.retry(context.Background(), rateLimitGroupAlerts, func() (interface{}, error) {
+		calls++
+		return nil, &sendgrid.RateLimitedError{RetryAfter: 20 * time.Millisecond}
+	})
+	var rle *sendgrid.RateLimitedError
+	if !errors.As(err, &rle) {
+		t.Fatalf("got error %v, want a rate limit error", err)
+	}
+	// Only the retries whose reset falls within the max wait are made.
+	if calls < 2 || calls > 3 {
+		t.Errorf("got %d calls, want 2 or 3", calls)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("gave up after %s, want about the max wait", elapsed)
+	}
+}
+
+func TestRateLimitWaitTime(t *testing.T) {
+	reset := time.Now().Add(42 * time.Second)
+
+	cases := []struct {
+		name    string
+		rle     *sendgrid.RateLimitedError
+		retry   int
+		maxWait time.Duration
+		want    time.Duration
+	}{
+		{
+			name:    "waits until the reset",
+			rle:     &sendgrid.RateLimitedError{RetryAfter: time.Until(reset).Round(time.Second)},
+			retry:   2,
+			maxWait: time.Minute,
+			want:    42 * time.Second,
+		},
+		{
+			name:    "reset capped by max wait",
+			rle:     &sendgrid.RateLimitedError{RetryAfter: 10 * time.Minute},
+			maxWait: time.Minute,
+			want:    time.Minute,
+		},
+		{
+			name:    "backoff when the reset has passed",
+			rle:     &sendgrid.RateLimitedError{RetryAfter: -time.Second},
+			retry:   2,
+			maxWait: time.Minute,
+			want:    4 * time.Second,
+		},
+		{
+			name:    "backoff capped",
+			rle:     &sendgrid.RateLimitedError{},
+			retry:   10,
+			maxWait: 5 * time.Minute,
+			want:    rateLimitMaxDelay,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := rateLimitWaitTime(c.rle, c.retry, c.maxWait); got != c.want {
+				t.Errorf("got %s, want %s", got, c.want)
+			}
+		})
+	}
+}
+
+func TestRateLimitReset(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	cases := []struct {
+		name       string
+		retryAfter string
+		want       time.Time
+	}{
+		{name: "seconds", retryAfter: "30", want: now.Add(30 * time.Second)},
+		{name: "http date", retryAfter: now.Add(time.Minute).Format(http.TimeFormat), want: now.Add(time.Minute)},
+		{name: "absent", want: now.Add(rateLimitBaseDelay)},
+		{name: "malformed", retryAfter: "soon", want: now.Add(rateLimitBaseDelay)},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			h := http.Header{}
+			if c.retryAfter != "" {
+				h.Set("Retry-After", c.retryAfter)
+			}
+			if got := rateLimitReset(h, now); !got.Equal(c.want) {
+				t.Errorf("got %s, want %s", got, c.want)
+			}
+		})
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRateLimitResetTransport(t *testing.T) {
+	reset := time.Now().Add(time.Hour).Unix()
+
+	cases := []struct {
+		name    string
+		header  http.Header
+		wantMin time.Duration
+		wantMax time.Duration
+	}{
+		{
+			name:    "x-ratelimit-reset kept",
+			header:  http.Header{"X-Ratelimit-Reset": []string{strconv.FormatInt(reset, 10)}},
+			wantMin: 59 * time.Minute,
+			wantMax: time.Hour,
+		},
+		{
+			name:    "retry-after",
+			header:  http.Header{"Retry-After": []string{"5"}},
+			wantMin: 4 * time.Second,
+			wantMax: 6 * time.Second,
+		},
+		{
+			name:    "default",
+			header:  http.Header{},
+			wantMin: 0,
+			wantMax: 2 * time.Second,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rt := rateLimitResetTransport{base: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusTooManyRequests,
+					Status:     http.StatusText(http.StatusTooManyRequests),
+					Header:     c.header,
+					Body:       io.NopCloser(strings.NewReader("")),
+					Request:    req,
+				}, nil
+			})}
+
+			_, err := newMockClient(rt).GetAlert(context.Background(), 1)
+			var rle *sendgrid.RateLimitedError
+			if !errors.As(err, &rle) {
+				t.Fatalf("got error %v, want a rate limit error", err)
+			}
+			if rle.RetryAfter < c.wantMin || rle.RetryAfter > c.wantMax {
+				t.Errorf("got retry after %s, want between %s and %s", rle.RetryAfter, c.wantMin, c.wantMax)
+			}
+		})
+	}
+}
+
+func TestClassifyRetry(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		want retryClass
+	}{
+		{name: "nil", err: nil, want: retryNever},
+		{name: "rate limited", err: &sendgrid.RateLimitedError{RetryAfter: time.Second}, want: retryRateLimited},
+		{name: "wrapped rate limited", err: fmt.Errorf("create alert: %w", &sendgrid.RateLimitedError{}), want: retryRateLimited},
+		{name: "bad request", err: testStatusCodeError{http.StatusBadRequest}, want: retryNever},
+		{name: "not found", err: testStatusCodeError{http.StatusNotFound}, want: retryNever},
+		{name: "internal server error", err: testStatusCodeError{http.StatusInternalServerError}, want: retryTransient},
+		{name: "service unavailable", err: testStatusCodeError{http.StatusServiceUnavailable}, want: retryTransient},
+		{name: "not implemented", err: testStatusCodeError{http.StatusNotImplemented}, want: retryNever},
+		{name: "network", err: &url.Error{Op: "Get", URL: "https://api.sendgrid.com/v3/alerts", Err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}}, want: retryTransient},
+		{name: "canceled", err: context.Canceled, want: retryNever},
+		{name: "deadline exceeded", err: &url.Error{Op: "Get", URL: "https://api.sendgrid.com/v3/alerts", Err: context.DeadlineExceeded}, want: retryNever},
+		{name: "error body", err: errors.New("field: name, message: is required"), want: retryNever},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := classifyRetry(c.err); got != c.want {
+				t.Errorf("got %d, want %d", got, c.want)
+			}
+		})
+	}
+}
+
+type testStatusCodeError struct {
+	code int
+}
+
+func (e testStatusCodeError) Error() string       { return http.StatusText(e.code) }
+func (e testStatusCodeError) HTTPStatusCode() int { return e.code }
+
+// testStatusSequenceClient returns a client whose nth request gets the nth status code, and a counter of its requests.
+func testStatusSequenceClient(statuses ...int) (*sendgrid.Client, *int) {
+	calls := 0
+	rt := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		status := statuses[min(calls, len(statuses)-1)]
+		calls++
+		body := `{"id": 1}`
+		if status == http.StatusBadRequest {
+			body = `{"errors": [{"field": "email_to", "message": "is invalid"}]}`
+		}
+		if status >= http.StatusInternalServerError {
+			body = ""
+		}
+		return &http.Response{
+			StatusCode: status,
+			Status:     http.StatusText(status),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Request:    req,
+		}, nil
+	})
+	return newMockClient(rt), &calls
+}
+
+func TestRateLimitBucketsClientErrorReturnsImmediately(t *testing.T) {
+	b := newRateLimitBuckets()
+	client, calls := testStatusSequenceClient(http.StatusBadRequest, http.StatusOK)
+
+	_, err := b.retry(context.Background(), rateLimitGroupAlerts, func() (interface{}, error) {
+		return client.GetAlert(context.Background(), 1)
+	})
+	if err == nil || !strings.Contains(err.Error(), "is invalid") {
+		t.Errorf("got error %v, want the 400 error", err)
+	}
+	if *calls != 1 {
+		t.Errorf("got %d calls, want 1", *calls)
+	}
+}
+
+func TestRateLimitBucketsRetriesTransientError(t *testing.T) {
+	b := newRateLimitBuckets()
+	b.backoffBaseDelay = time.Millisecond
+	client, calls := testStatusSequenceClient(http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK)
+
+	res, err := b.retry(context.Background(), rateLimitGroupAlerts, func() (interface{}, error) {
+		return client.GetAlert(context.Background(), 1)
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if o, ok := res.(*sendgrid.OutputGetAlert); !ok || o.ID != 1 {
+		t.Errorf("got %v, want the alert", res)
+	}
+	if *calls != 3 {
+		t.Errorf("got %d calls, want 3", *calls)
+	}
+
+	// The transient error is returned once the retries run out.
+	b.configure(1, time.Minute)
+	client, calls = testStatusSequenceClient(http.StatusServiceUnavailable)
+	_, err = b.retry(context.Background(), rateLimitGroupAlerts, func() (interface{}, error) {
+		return client.GetAlert(context.Background(), 1)
+	})
+	if classifyRetry(err) != retryTransient {
+		t.Errorf("got error %v, want the 503 error", err)
+	}
+	if *calls != 2 {
+		t.Errorf("got %d calls, want 2", *calls)
+	}
+}
+
+func TestRateLimitBucketsNonIdempotentRetriesOnlyRateLimits(t *testing.T) {
+	b := newRateLimitBuckets()
+	b.backoffBaseDelay = time.Millisecond
+	client, calls := testStatusSequenceClient(http.StatusServiceUnavailable, http.StatusOK)
+
+	// The request may have been processed before the 503, so it is not sent again.
+	_, err := b.retryNonIdempotent(context.Background(), rateLimitGroupAlerts, func() (interface{}, error) {
+		return client.CreateAlert(context.Background(), &sendgrid.InputCreateAlert{Type: "stats_notification", EmailTo: "test@example.com", Frequency: "daily"})
+	})
+	if classifyRetry(err) != retryTransient {
+		t.Errorf("got error %v, want the 503 error", err)
+	}
+	if *calls != 1 {
+		t.Errorf("got %d calls, want 1", *calls)
+	}
+
+	// A rate limited request is rejected without being processed, so it is retried.
+	attempts := 0
+	res, err := b.retryNonIdempotent(context.Background(), rateLimitGroupAlerts, func() (interface{}, error) {
+		attempts++
+		if attempts == 1 {
+			return nil, &sendgrid.RateLimitedError{RetryAfter: time.Millisecond}
+		}
+		return "created", nil
+	})
+	if err != nil || res != "created" {
+		t.Errorf("got %v, %v, want the retried result", res, err)
+	}
+	if attempts != 2 {
+		t.Errorf("got %d attempts, want 2", attempts)
+	}
+}
@@ -25,7 +25,8 @@ func newReverseDNSDataSource() datasource.DataSource {
 }
 
 type reverseDNSDataSource struct {
-	client *sendgrid.Client
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
 }
 
 type reverseDNSDataSourceModel struct {
@@ -51,16 +52,17 @@ func (d *reverseDNSDataSource) Configure(ctx context.Context, req datasource.Con
 		return
 	}
 
-	client, ok := req.ProviderData.(*sendgrid.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = data.client
+	d.rateLimits = data.rateLimits
 }
 
 func (d *reverseDNSDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
@@ -153,7 +155,7 @@ func (d *reverseDNSDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading reverseDNS",
-			fmt.Sprintf("Unable to read reverseDNS (id: %v), got error: %s", id, err),
+			fmt.Sprintf("Unable to read reverseDNS (id: %v), got error: %s", id, sendgridErrorDetail(err)),
 		)
 		return
 	}
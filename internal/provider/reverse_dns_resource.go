@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -28,7 +29,8 @@ func newReverseDNSResource() resource.Resource {
 }
 
 type reverseDNSResource struct {
-	client *sendgrid.Client
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
 }
 
 type reverseDNSResourceModel struct {
@@ -42,6 +44,8 @@ type reverseDNSResourceModel struct {
 	Legacy                types.Bool   `tfsdk:"legacy"`
 	LastValidationAttempt types.Int64  `tfsdk:"last_validation_attempt"`
 	ARecord               types.Object `tfsdk:"a_record"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 var aRecordObjectAttribute = map[string]attr.Type{
@@ -135,6 +139,9 @@ For more about Reverse DNS, see ["How to set up reverse DNS"](https://sendgrid.c
 				AttributeTypes: aRecordObjectAttribute,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -143,18 +150,19 @@ func (r *reverseDNSResource) Configure(ctx context.Context, req resource.Configu
 		return
 	}
 
-	client, ok := req.ProviderData.(*sendgrid.Client)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+	r.rateLimits = data.rateLimits
 }
 
 func (r *reverseDNSResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -164,6 +172,16 @@ func (r *reverseDNSResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "create", plan.ID)
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	input := &sendgrid.InputCreateReverseDNS{
 		IP:     plan.IP.ValueString(),
 		Domain: plan.Domain.ValueString(),
@@ -173,13 +191,13 @@ func (r *reverseDNSResource) Create(ctx context.Context, req resource.CreateRequ
 		input.Subdomain = plan.Subdomain.ValueString()
 	}
 
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
+	res, err := r.rateLimits.retryNonIdempotent(ctx, rateLimitGroupWhitelabel, func() (interface{}, error) {
 		return r.client.CreateReverseDNS(ctx, input)
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Creating reverseDNS",
-			fmt.Sprintf("Unable to create reverseDNS, got error: %s", err),
+			fmt.Sprintf("Unable to create reverseDNS, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -204,6 +222,7 @@ func (r *reverseDNSResource) Create(ctx context.Context, req resource.CreateRequ
 		Legacy:                types.BoolValue(o.Legacy),
 		LastValidationAttempt: types.Int64Value(o.LastValidationAttemptAt),
 		ARecord:               newARecord(o.ARecord),
+		Timeouts:              plan.Timeouts,
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -218,6 +237,16 @@ func (r *reverseDNSResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "read", state.ID)
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	reverseDNSID := state.ID.ValueString()
 	id, _ := strconv.ParseInt(reverseDNSID, 10, 64)
 
@@ -225,7 +254,7 @@ func (r *reverseDNSResource) Read(ctx context.Context, req resource.ReadRequest,
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading reverseDNS",
-			fmt.Sprintf("Unable to read reverseDNS (id: %v), got error: %s", id, err),
+			fmt.Sprintf("Unable to read reverseDNS (id: %v), got error: %s", id, sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -241,6 +270,7 @@ func (r *reverseDNSResource) Read(ctx context.Context, req resource.ReadRequest,
 		Legacy:                types.BoolValue(o.Legacy),
 		LastValidationAttempt: types.Int64Value(o.LastValidationAttemptAt),
 		ARecord:               newARecord(o.ARecord),
+		Timeouts:              state.Timeouts,
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -262,22 +292,34 @@ func (r *reverseDNSResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "delete", state.ID)
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	reverseDNSID := state.ID.ValueString()
 	id, _ := strconv.ParseInt(reverseDNSID, 10, 64)
 
-	_, err := retryOnRateLimit(ctx, func() (interface{}, error) {
+	_, err := r.rateLimits.retry(ctx, rateLimitGroupWhitelabel, func() (interface{}, error) {
 		return nil, r.client.DeleteReverseDNS(ctx, id)
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Deleting reverseDNS",
-			fmt.Sprintf("Unable to delete reverseDNS (id: %v), got error: %s", id, err),
+			fmt.Sprintf("Unable to delete reverseDNS (id: %v), got error: %s", id, sendgridErrorDetail(err)),
 		)
 		return
 	}
 }
 
 func (r *reverseDNSResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = withAPILogFields(ctx, "import", types.StringValue(req.ID))
+
 	var data reverseDNSResourceModel
 
 	reverseDNSID := req.ID
@@ -289,7 +331,7 @@ func (r *reverseDNSResource) ImportState(ctx context.Context, req resource.Impor
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Importing reverseDNS",
-			fmt.Sprintf("Unable to read reverse DNS, got error: %s", err),
+			fmt.Sprintf("Unable to read reverse DNS, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -305,6 +347,7 @@ func (r *reverseDNSResource) ImportState(ctx context.Context, req resource.Impor
 		Legacy:                types.BoolValue(o.Legacy),
 		LastValidationAttempt: types.Int64Value(o.LastValidationAttemptAt),
 		ARecord:               newARecord(o.ARecord),
+		Timeouts:              nullTimeouts(),
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
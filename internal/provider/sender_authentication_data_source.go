@@ -25,7 +25,8 @@ func newSenderAuthenticationDataSource() datasource.DataSource {
 }
 
 type senderAuthenticationDataSource struct {
-	client *sendgrid.Client
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
 }
 
 type senderAuthenticationDataSourceModel struct {
@@ -52,16 +53,17 @@ func (d *senderAuthenticationDataSource) Configure(ctx context.Context, req data
 		return
 	}
 
-	client, ok := req.ProviderData.(*sendgrid.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = data.client
+	d.rateLimits = data.rateLimits
 }
 
 func (d *senderAuthenticationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
@@ -156,7 +158,7 @@ func (d *senderAuthenticationDataSource) Read(ctx context.Context, req datasourc
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading sender authentication",
-			fmt.Sprintf("Unable to get authenticated domain, got error: %s", err),
+			fmt.Sprintf("Unable to get authenticated domain, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -176,6 +178,7 @@ func (d *senderAuthenticationDataSource) Read(ctx context.Context, req datasourc
 	s.Legacy = types.BoolValue(o.Legacy)
 	s.Valid = types.BoolValue(o.Valid)
 	s.DNS = convertDNSToSetType(o.DNS)
+	resp.Diagnostics.Append(checkDomainDNSRecords(o.DNS)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &s)...)
 	if resp.Diagnostics.HasError() {
@@ -6,15 +6,21 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strconv"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/i10416/sendgrid"
@@ -25,26 +31,49 @@ import (
 var _ resource.Resource = &senderAuthenticationResource{}
 var _ resource.ResourceWithImportState = &senderAuthenticationResource{}
 
+// A custom DKIM selector is used in the generated DKIM record names, and SendGrid accepts three letters or numbers.
+var customDkimSelectorValidator = stringvalidator.RegexMatches(
+	regexp.MustCompile(`^[a-zA-Z0-9]{3}$`),
+	"must be three letters or numbers",
+)
+
 func newSenderAuthenticationResource() resource.Resource {
 	return &senderAuthenticationResource{}
 }
 
 type senderAuthenticationResource struct {
-	client *sendgrid.Client
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
 }
 
 type senderAuthenticationResourceModel struct {
-	ID                 types.String `tfsdk:"id"`
-	UserID             types.Int64  `tfsdk:"user_id"`
-	Domain             types.String `tfsdk:"domain"`
-	Subdomain          types.String `tfsdk:"subdomain"`
-	Username           types.String `tfsdk:"username"`
-	IPs                types.Set    `tfsdk:"ips"`
-	Default            types.Bool   `tfsdk:"default"`
-	Legacy             types.Bool   `tfsdk:"legacy"`
-	CustomDkimSelector types.String `tfsdk:"custom_dkim_selector"`
-	DNS                types.Set    `tfsdk:"dns"`
-	Valid              types.Bool   `tfsdk:"valid"`
+	ID                 types.String   `tfsdk:"id"`
+	UserID             types.Int64    `tfsdk:"user_id"`
+	Domain             types.String   `tfsdk:"domain"`
+	Subdomain          types.String   `tfsdk:"subdomain"`
+	Username           types.String   `tfsdk:"username"`
+	IPs                types.Set      `tfsdk:"ips"`
+	Default            types.Bool     `tfsdk:"default"`
+	CustomSpf          types.Bool     `tfsdk:"custom_spf"`
+	Legacy             types.Bool     `tfsdk:"legacy"`
+	CustomDkimSelector types.String   `tfsdk:"custom_dkim_selector"`
+	DNS                types.Set      `tfsdk:"dns"`
+	Valid              types.Bool     `tfsdk:"valid"`
+	AllowDefaultDelete types.Bool     `tfsdk:"allow_default_delete"`
+	Validate           types.Bool     `tfsdk:"validate"`
+	ValidationResults  types.List     `tfsdk:"validation_results"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+// The DNS records SendGrid checks when validating an authenticated domain, in the order they are reported.
+var domainValidationRecords = []string{"mail_cname", "dkim1", "dkim2", "spf"}
+
+var domainValidationResultType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"record": types.StringType,
+		"valid":  types.BoolType,
+		"reason": types.StringType,
+	},
 }
 
 func (r *senderAuthenticationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -99,6 +128,44 @@ For more detailed information, please see the [SendGrid documentation](https://d
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
 			},
+			"custom_spf": schema.BoolAttribute{
+				MarkdownDescription: "Whether to generate a custom SPF record for manual security instead of the CNAME records of automatic security.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"validate": schema.BoolAttribute{
+				MarkdownDescription: "Whether to ask SendGrid to validate the DNS records when the resource is created or updated. Publish the records in `dns` first, then set this to true to validate them in place. The result of each record is reported in `validation_results`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"validation_results": schema.ListNestedAttribute{
+				MarkdownDescription: "The result of the last validation requested by `validate`, one entry per DNS record. Null until a validation is requested.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"record": schema.StringAttribute{
+							MarkdownDescription: "The DNS record validated. One of " + flex.QuoteAndJoin(domainValidationRecords) + ".",
+							Computed:            true,
+						},
+						"valid": schema.BoolAttribute{
+							MarkdownDescription: "Whether the DNS record is published correctly.",
+							Computed:            true,
+						},
+						"reason": schema.StringAttribute{
+							MarkdownDescription: "Why the DNS record is invalid. Empty for valid records.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"allow_default_delete": schema.BoolAttribute{
+				MarkdownDescription: "Whether to allow deleting this authenticated domain while it is the default. Deleting the default authenticated domain can break production sending, so it fails unless this is set to true.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"legacy": schema.BoolAttribute{
 				MarkdownDescription: "Whether to use this authenticated domain as the fallback if no authenticated domains match the sender's domain.",
 				Computed:            true,
@@ -106,6 +173,9 @@ For more detailed information, please see the [SendGrid documentation](https://d
 			"custom_dkim_selector": schema.StringAttribute{
 				MarkdownDescription: "Add a custom DKIM selector. Accepts three letters or numbers.",
 				Optional:            true,
+				Validators: []validator.String{
+					customDkimSelectorValidator,
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -138,6 +208,9 @@ For more detailed information, please see the [SendGrid documentation](https://d
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -146,18 +219,19 @@ func (r *senderAuthenticationResource) Configure(ctx context.Context, req resour
 		return
 	}
 
-	client, ok := req.ProviderData.(*sendgrid.Client)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+	r.rateLimits = data.rateLimits
 }
 
 func (r *senderAuthenticationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -167,6 +241,16 @@ func (r *senderAuthenticationResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "create", data.ID)
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	domain := data.Domain.ValueString()
 	input := &sendgrid.InputAuthenticateDomain{
 		Domain: domain,
@@ -188,18 +272,19 @@ func (r *senderAuthenticationResource) Create(ctx context.Context, req resource.
 	if def {
 		input.Default = def
 	}
+	input.CustomSpf = data.CustomSpf.ValueBool()
 	customDkimSelector := data.CustomDkimSelector.ValueString()
 	if customDkimSelector != "" {
 		input.CustomDkimSelector = customDkimSelector
 	}
 
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-		return r.client.AuthenticateDomain(context.TODO(), input)
+	res, err := r.rateLimits.retryNonIdempotent(ctx, rateLimitGroupWhitelabel, func() (interface{}, error) {
+		return r.client.AuthenticateDomain(ctx, input)
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Creating sender authentication",
-			fmt.Sprintf("Unable to authenticate domain, got error: %s", err),
+			fmt.Sprintf("Unable to authenticate domain, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -227,9 +312,17 @@ func (r *senderAuthenticationResource) Create(ctx context.Context, req resource.
 	data.Subdomain = types.StringValue(o.Subdomain)
 	data.Username = types.StringValue(o.Username)
 	data.Default = types.BoolValue(o.Default)
+	data.CustomSpf = types.BoolValue(o.CustomSpf)
 	data.Legacy = types.BoolValue(o.Legacy)
 	data.Valid = types.BoolValue(o.Valid)
 	data.DNS = convertDNSToSetType(o.DNS)
+	resp.Diagnostics.Append(checkDomainDNSRecords(o.DNS)...)
+	data.ValidationResults = types.ListNull(domainValidationResultType)
+
+	// NOTE: The records were just generated, so they are usually not published yet and validation reports them as invalid.
+	if data.Validate.ValueBool() {
+		resp.Diagnostics.Append(r.validate(ctx, o.ID, &data)...)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -244,13 +337,23 @@ func (r *senderAuthenticationResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "read", data.ID)
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	id := data.ID.ValueString()
 	domainId, _ := strconv.ParseInt(id, 10, 64)
 	o, err := r.client.GetAuthenticatedDomain(ctx, domainId)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading sender authentication",
-			fmt.Sprintf("Unable to get authenticated domain, got error: %s", err),
+			fmt.Sprintf("Unable to get authenticated domain, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -267,9 +370,11 @@ func (r *senderAuthenticationResource) Read(ctx context.Context, req resource.Re
 	data.Subdomain = types.StringValue(o.Subdomain)
 	data.Username = types.StringValue(o.Username)
 	data.Default = types.BoolValue(o.Default)
+	data.CustomSpf = types.BoolValue(o.CustomSpf)
 	data.Legacy = types.BoolValue(o.Legacy)
 	data.Valid = types.BoolValue(o.Valid)
 	data.DNS = convertDNSToSetType(o.DNS)
+	resp.Diagnostics.Append(checkDomainDNSRecords(o.DNS)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -285,16 +390,27 @@ func (r *senderAuthenticationResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "update", data.ID)
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	id := data.ID.ValueString()
 	domainId, _ := strconv.ParseInt(id, 10, 64)
 
 	o, err := r.client.UpdateDomainAuthentication(ctx, domainId, &sendgrid.InputUpdateDomainAuthentication{
-		Default: data.Default.ValueBool(),
+		Default:   data.Default.ValueBool(),
+		CustomSpf: data.CustomSpf.ValueBool(),
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Updating sender authentication",
-			fmt.Sprintf("Unable to update authenticated domain, got error: %s", err),
+			fmt.Sprintf("Unable to update authenticated domain, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -312,9 +428,16 @@ func (r *senderAuthenticationResource) Update(ctx context.Context, req resource.
 	data.Username = types.StringValue(o.Username)
 	data.IPs = ipsSet
 	data.Default = types.BoolValue(o.Default)
+	data.CustomSpf = types.BoolValue(o.CustomSpf)
 	data.Legacy = types.BoolValue(o.Legacy)
 	data.Valid = types.BoolValue(o.Valid)
 	data.DNS = convertDNSToSetType(o.DNS)
+	resp.Diagnostics.Append(checkDomainDNSRecords(o.DNS)...)
+	data.ValidationResults = state.ValidationResults
+
+	if data.Validate.ValueBool() {
+		resp.Diagnostics.Append(r.validate(ctx, o.ID, &data)...)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -322,6 +445,37 @@ func (r *senderAuthenticationResource) Update(ctx context.Context, req resource.
 	}
 }
 
+// validate asks SendGrid to validate the DNS records of the authenticated domain and stores the results in data.
+func (r *senderAuthenticationResource) validate(ctx context.Context, domainId int64, data *senderAuthenticationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// NOTE: Re-execute after the re-executable time has elapsed when a rate limit occurs
+	res, err := r.rateLimits.retry(ctx, rateLimitGroupWhitelabel, func() (interface{}, error) {
+		return r.client.ValidateDomainAuthentication(ctx, domainId)
+	})
+	if err != nil {
+		diags.AddError(
+			"Validating sender authentication",
+			fmt.Sprintf("Unable to validate authenticated domain (id: %d), got error: %s", domainId, sendgridErrorDetail(err)),
+		)
+		return diags
+	}
+
+	o, ok := res.(*sendgrid.OutputValidateDomainAuthentication)
+	if !ok {
+		diags.AddError(
+			"Validating sender authentication",
+			"Failed to assert type *sendgrid.OutputValidateDomainAuthentication",
+		)
+		return diags
+	}
+
+	data.Valid = types.BoolValue(o.Valid)
+	data.ValidationResults = convertValidationResultsToListType(o.ValidationResults)
+	diags.Append(invalidDomainRecordsDiagnostics(domainId, o)...)
+	return diags
+}
+
 func (r *senderAuthenticationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data senderAuthenticationResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -329,21 +483,45 @@ func (r *senderAuthenticationResource) Delete(ctx context.Context, req resource.
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "delete", data.ID)
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	domainId := data.ID.ValueString()
+	if data.Default.ValueBool() && !data.AllowDefaultDelete.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Deleting sender authentication",
+			fmt.Sprintf(
+				"Refusing to delete authenticated domain (id: %s) because it is the default authenticated domain. "+
+					"Set allow_default_delete to true and apply before deleting it, or make another domain the default.",
+				domainId,
+			),
+		)
+		return
+	}
+
 	id, _ := strconv.ParseInt(domainId, 10, 64)
-	_, err := retryOnRateLimit(ctx, func() (interface{}, error) {
+	_, err := r.rateLimits.retry(ctx, rateLimitGroupWhitelabel, func() (interface{}, error) {
 		return nil, r.client.DeleteAuthenticatedDomain(ctx, id)
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Deleting sender authentication",
-			fmt.Sprintf("Unable to delete authenticated domain (id: %s), got error: %s", domainId, err),
+			fmt.Sprintf("Unable to delete authenticated domain (id: %s), got error: %s", domainId, sendgridErrorDetail(err)),
 		)
 		return
 	}
 }
 
 func (r *senderAuthenticationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = withAPILogFields(ctx, "import", types.StringValue(req.ID))
+
 	var data senderAuthenticationResourceModel
 
 	domainId := req.ID
@@ -354,7 +532,7 @@ func (r *senderAuthenticationResource) ImportState(ctx context.Context, req reso
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Importing sender authentication",
-			fmt.Sprintf("Unable to parse int (id: %s), got error: %s", domainId, err),
+			fmt.Sprintf("Unable to parse int (id: %s), got error: %s", domainId, sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -363,7 +541,7 @@ func (r *senderAuthenticationResource) ImportState(ctx context.Context, req reso
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Importing sender authentication",
-			fmt.Sprintf("Unable to get authenticated domain (id: %s), got error: %s", domainId, err),
+			fmt.Sprintf("Unable to get authenticated domain (id: %s), got error: %s", domainId, sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -381,9 +559,15 @@ func (r *senderAuthenticationResource) ImportState(ctx context.Context, req reso
 	data.Username = types.StringValue(o.Username)
 	data.IPs = ipsSet
 	data.Default = types.BoolValue(o.Default)
+	data.CustomSpf = types.BoolValue(o.CustomSpf)
 	data.Legacy = types.BoolValue(o.Legacy)
 	data.Valid = types.BoolValue(o.Valid)
 	data.DNS = convertDNSToSetType(o.DNS)
+	resp.Diagnostics.Append(checkDomainDNSRecords(o.DNS)...)
+	data.AllowDefaultDelete = types.BoolValue(false)
+	data.Validate = types.BoolValue(false)
+	data.ValidationResults = types.ListNull(domainValidationResultType)
+	data.Timeouts = nullTimeouts()
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -391,6 +575,87 @@ func (r *senderAuthenticationResource) ImportState(ctx context.Context, req reso
 	}
 }
 
+// checkDomainDNSRecords warns when SendGrid did not return every DNS record of an authenticated domain,
+// so that a partial dns block is not silently stored.
+func checkDomainDNSRecords(dns sendgrid.DNS) diag.Diagnostics {
+	var missing []string
+	if dns.MailCname.Type == "" {
+		missing = append(missing, "mail_cname")
+	}
+	if dns.Dkim1.Type == "" {
+		missing = append(missing, "dkim1")
+	}
+	if dns.Dkim2.Type == "" {
+		missing = append(missing, "dkim2")
+	}
+	return incompleteDNSRecordsDiagnostics("authenticated domain", missing)
+}
+
+// incompleteDNSRecordsDiagnostics returns a warning listing the missing DNS records, if any.
+func incompleteDNSRecordsDiagnostics(what string, missing []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if len(missing) > 0 {
+		diags.AddAttributeWarning(
+			path.Root("dns"),
+			"Incomplete DNS records",
+			fmt.Sprintf(
+				"SendGrid did not return every DNS record of the %s, so the dns attribute is incomplete. Missing records: %s",
+				what, strings.Join(missing, ", "),
+			),
+		)
+	}
+	return diags
+}
+
+// domainValidationResults returns the validation result of each record in the order of domainValidationRecords.
+func domainValidationResults(r sendgrid.ValidationResults) []sendgrid.ValidationResult {
+	return []sendgrid.ValidationResult{r.MailCname, r.Dkim1, r.Dkim2, r.SPF}
+}
+
+func convertValidationResultsToListType(r sendgrid.ValidationResults) types.List {
+	var results []attr.Value
+	for i, v := range domainValidationResults(r) {
+		results = append(results, types.ObjectValueMust(
+			domainValidationResultType.AttrTypes,
+			map[string]attr.Value{
+				"record": types.StringValue(domainValidationRecords[i]),
+				"valid":  types.BoolValue(v.Valid),
+				"reason": types.StringValue(v.Reason),
+			},
+		))
+	}
+	return types.ListValueMust(domainValidationResultType, results)
+}
+
+// invalidDomainRecordsDiagnostics warns about the DNS records that failed validation, with the reason SendGrid gave.
+func invalidDomainRecordsDiagnostics(domainId int64, o *sendgrid.OutputValidateDomainAuthentication) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if o.Valid {
+		return diags
+	}
+
+	var invalid []string
+	for i, v := range domainValidationResults(o.ValidationResults) {
+		if v.Valid {
+			continue
+		}
+		// NOTE: SendGrid reports no reason for records it does not check, e.g. spf without custom_spf.
+		if v.Reason == "" {
+			continue
+		}
+		invalid = append(invalid, fmt.Sprintf("%s: %s", domainValidationRecords[i], v.Reason))
+	}
+	diags.AddAttributeWarning(
+		path.Root("validation_results"),
+		"Sender authentication is not valid",
+		fmt.Sprintf(
+			"SendGrid could not validate the DNS records of authenticated domain (id: %d). Publish the records in dns and validate again. Invalid records:\n%s",
+			domainId, strings.Join(invalid, "\n"),
+		),
+	)
+	return diags
+}
+
 func convertDNSToSetType(dns sendgrid.DNS) (recordsSet basetypes.SetValue) {
 	var records []attr.Value
 
@@ -4,11 +4,18 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/i10416/sendgrid"
 )
 
 func TestAccSenderAuthenticationResource(t *testing.T) {
@@ -52,6 +59,248 @@ func TestAccSenderAuthenticationResource(t *testing.T) {
 	})
 }
 
+func TestAccSenderAuthenticationResourceDefaultDelete(t *testing.T) {
+	resourceName := "sendgrid_sender_authentication.test"
+
+	domain := fmt.Sprintf("test-acc-%s.com", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSenderAuthenticationResourceDefaultConfig(domain, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "default", "true"),
+					resource.TestCheckResourceAttr(resourceName, "allow_default_delete", "false"),
+				),
+			},
+			// The default authenticated domain cannot be deleted without allow_default_delete.
+			{
+				Config:      testAccSenderAuthenticationResourceDefaultConfig(domain, false),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("Refusing to delete authenticated domain"),
+			},
+			// Opting in allows the domain to be destroyed at the end of the test.
+			{
+				Config: testAccSenderAuthenticationResourceDefaultConfig(domain, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "default", "true"),
+					resource.TestCheckResourceAttr(resourceName, "allow_default_delete", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSenderAuthenticationResourceCustomDkimSelector(t *testing.T) {
+	resourceName := "sendgrid_sender_authentication.test"
+
+	domain := fmt.Sprintf("test-acc-%s.com", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid selectors are rejected at plan time
+			{
+				Config:      testAccSenderAuthenticationResourceCustomDkimSelectorConfig(domain, "s-1"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("must be three letters or numbers"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccSenderAuthenticationResourceCustomDkimSelectorConfig(domain, "tf1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "custom_dkim_selector", "tf1"),
+				),
+			},
+		},
+	})
+}
+
+func TestCustomDkimSelectorValidator(t *testing.T) {
+	cases := []struct {
+		selector string
+		wantErr  bool
+	}{
+		{selector: "abc"},
+		{selector: "s01"},
+		{selector: "ABC"},
+		{selector: "ab", wantErr: true},
+		{selector: "abcd", wantErr: true},
+		{selector: "s-1", wantErr: true},
+		{selector: "", wantErr: true},
+	}
+
+	for _, c := range cases {
+		resp := &validator.StringResponse{}
+		customDkimSelectorValidator.ValidateString(context.Background(), validator.StringRequest{
+			Path:        path.Root("custom_dkim_selector"),
+			ConfigValue: types.StringValue(c.selector),
+		}, resp)
+		if got := resp.Diagnostics.HasError(); got != c.wantErr {
+			t.Errorf("selector %q has error %t, want %t", c.selector, got, c.wantErr)
+		}
+	}
+}
+
+func TestCheckDomainDNSRecords(t *testing.T) {
+	var dns sendgrid.DNS
+	dns.MailCname.Type = "cname"
+	dns.Dkim1.Type = "cname"
+	dns.Dkim2.Type = "cname"
+	if diags := checkDomainDNSRecords(dns); len(diags) != 0 {
+		t.Errorf("unexpected diagnostics for complete records: %v", diags)
+	}
+
+	// dkim2 is missing from the response.
+	dns.Dkim2.Type = ""
+	diags := checkDomainDNSRecords(dns)
+	if diags.WarningsCount() != 1 || diags.HasError() {
+		t.Fatalf("got %v, want a single warning", diags)
+	}
+	if detail := diags[0].Detail(); !strings.Contains(detail, "dkim2") || strings.Contains(detail, "dkim1") {
+		t.Errorf("got detail %q, want it to name only dkim2", detail)
+	}
+}
+
+func TestAccSenderAuthenticationResourceValidate(t *testing.T) {
+	resourceName := "sendgrid_sender_authentication.test"
+
+	domain := fmt.Sprintf("test-acc-%s.com", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create without validation
+			{
+				Config: testAccSenderAuthenticationResourceValidateConfig(domain, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "custom_spf", "true"),
+					resource.TestCheckResourceAttr(resourceName, "dns.#", "3"),
+					resource.TestCheckNoResourceAttr(resourceName, "validation_results"),
+				),
+			},
+			// Validate in place. The records are not published, so every record is invalid.
+			{
+				Config: testAccSenderAuthenticationResourceValidateConfig(domain, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "valid", "false"),
+					resource.TestCheckResourceAttr(resourceName, "validation_results.#", "4"),
+					resource.TestCheckResourceAttr(resourceName, "validation_results.0.record", "mail_cname"),
+					resource.TestCheckResourceAttr(resourceName, "validation_results.0.valid", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestConvertDNSToSetType(t *testing.T) {
+	dns := sendgrid.DNS{
+		MailCname: sendgrid.Record{Valid: true, Type: "cname", Host: "em123.example.com", Data: "u123.wl.sendgrid.net"},
+		Dkim1:     sendgrid.Record{Type: "cname", Host: "s1._domainkey.example.com", Data: "s1.domainkey.u123.wl.sendgrid.net"},
+		Dkim2:     sendgrid.Record{Type: "cname", Host: "s2._domainkey.example.com", Data: "s2.domainkey.u123.wl.sendgrid.net"},
+	}
+
+	var got []struct {
+		Valid types.Bool   `tfsdk:"valid"`
+		Type  types.String `tfsdk:"type"`
+		Host  types.String `tfsdk:"host"`
+		Data  types.String `tfsdk:"data"`
+	}
+	if diags := convertDNSToSetType(dns).ElementsAs(context.Background(), &got, false); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(got) != 3 {
+		t.Fatalf("got %d records, want 3", len(got))
+	}
+
+	want := map[string]sendgrid.Record{
+		dns.MailCname.Host: dns.MailCname,
+		dns.Dkim1.Host:     dns.Dkim1,
+		dns.Dkim2.Host:     dns.Dkim2,
+	}
+	for _, r := range got {
+		w, ok := want[r.Host.ValueString()]
+		if !ok {
+			t.Errorf("unexpected record for host %s", r.Host)
+			continue
+		}
+		if r.Valid.ValueBool() != w.Valid || r.Type.ValueString() != w.Type || r.Data.ValueString() != w.Data {
+			t.Errorf("got record %+v, want %+v", r, w)
+		}
+	}
+
+	if !convertDNSToSetType(sendgrid.DNS{}).IsNull() {
+		t.Errorf("got records for an empty response, want null")
+	}
+}
+
+func TestConvertValidationResultsToListType(t *testing.T) {
+	results := sendgrid.ValidationResults{
+		MailCname: sendgrid.ValidationResult{Valid: true},
+		Dkim1:     sendgrid.ValidationResult{Reason: "Expected CNAME to match"},
+		Dkim2:     sendgrid.ValidationResult{Valid: true},
+	}
+
+	var got []struct {
+		Record types.String `tfsdk:"record"`
+		Valid  types.Bool   `tfsdk:"valid"`
+		Reason types.String `tfsdk:"reason"`
+	}
+	if diags := convertValidationResultsToListType(results).ElementsAs(context.Background(), &got, false); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	want := []struct {
+		record string
+		valid  bool
+		reason string
+	}{
+		{record: "mail_cname", valid: true},
+		{record: "dkim1", reason: "Expected CNAME to match"},
+		{record: "dkim2", valid: true},
+		{record: "spf"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d results, want %d", len(got), len(want))
+	}
+	for i, w := range want {
+		if got[i].Record.ValueString() != w.record || got[i].Valid.ValueBool() != w.valid || got[i].Reason.ValueString() != w.reason {
+			t.Errorf("result %d: got %+v, want %+v", i, got[i], w)
+		}
+	}
+}
+
+func TestInvalidDomainRecordsDiagnostics(t *testing.T) {
+	if diags := invalidDomainRecordsDiagnostics(1, &sendgrid.OutputValidateDomainAuthentication{Valid: true}); len(diags) != 0 {
+		t.Errorf("unexpected diagnostics for a valid domain: %v", diags)
+	}
+
+	diags := invalidDomainRecordsDiagnostics(1, &sendgrid.OutputValidateDomainAuthentication{
+		ValidationResults: sendgrid.ValidationResults{
+			MailCname: sendgrid.ValidationResult{Valid: true},
+			Dkim1:     sendgrid.ValidationResult{Reason: "Expected CNAME for s1._domainkey.example.com to match"},
+			Dkim2:     sendgrid.ValidationResult{Valid: true},
+		},
+	})
+	if diags.WarningsCount() != 1 || diags.HasError() {
+		t.Fatalf("got %v, want a single warning", diags)
+	}
+	detail := diags[0].Detail()
+	if !strings.Contains(detail, "dkim1: Expected CNAME") {
+		t.Errorf("got detail %q, want it to name dkim1 with its reason", detail)
+	}
+	for _, r := range []string{"mail_cname", "dkim2", "spf"} {
+		if strings.Contains(detail, r+":") {
+			t.Errorf("got detail %q, want it not to name %s", detail, r)
+		}
+	}
+}
+
 func testAccSenderAuthenticationResourceConfig(domain string) string {
 	return fmt.Sprintf(`
 resource "sendgrid_sender_authentication" "test" {
@@ -59,3 +308,32 @@ resource "sendgrid_sender_authentication" "test" {
 }
 `, domain)
 }
+
+func testAccSenderAuthenticationResourceDefaultConfig(domain string, allowDefaultDelete bool) string {
+	return fmt.Sprintf(`
+resource "sendgrid_sender_authentication" "test" {
+  domain               = "%[1]s"
+  default              = true
+  allow_default_delete = %[2]t
+}
+`, domain, allowDefaultDelete)
+}
+
+func testAccSenderAuthenticationResourceCustomDkimSelectorConfig(domain, selector string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_sender_authentication" "test" {
+  domain               = "%[1]s"
+  custom_dkim_selector = "%[2]s"
+}
+`, domain, selector)
+}
+
+func testAccSenderAuthenticationResourceValidateConfig(domain string, validate bool) string {
+	return fmt.Sprintf(`
+resource "sendgrid_sender_authentication" "test" {
+  domain     = "%[1]s"
+  custom_spf = true
+  validate   = %[2]t
+}
+`, domain, validate)
+}
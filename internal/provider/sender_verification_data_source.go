@@ -25,7 +25,8 @@ func newSenderVerificationDataSource() datasource.DataSource {
 }
 
 type senderVerificationDataSource struct {
-	client *sendgrid.Client
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
 }
 
 type senderVerificationDataSourceModel struct {
@@ -55,16 +56,17 @@ func (d *senderVerificationDataSource) Configure(ctx context.Context, req dataso
 		return
 	}
 
-	client, ok := req.ProviderData.(*sendgrid.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = data.client
+	d.rateLimits = data.rateLimits
 }
 
 func (d *senderVerificationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
@@ -154,7 +156,7 @@ func (d *senderVerificationDataSource) Read(ctx context.Context, req datasource.
 	if err != nil || len(senders) == 0 {
 		resp.Diagnostics.AddError(
 			"Reading sender verification",
-			fmt.Sprintf("Unable to get verified sender, got error: %s", err),
+			fmt.Sprintf("Unable to get verified sender, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -26,7 +27,8 @@ func newSenderVerificationResource() resource.Resource {
 }
 
 type senderVerificationResource struct {
-	client *sendgrid.Client
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
 }
 
 type senderVerificationResourceModel struct {
@@ -44,6 +46,10 @@ type senderVerificationResourceModel struct {
 	Country     types.String `tfsdk:"country"`
 	Verified    types.Bool   `tfsdk:"verified"`
 	Locked      types.Bool   `tfsdk:"locked"`
+
+	ResendVerification types.Bool `tfsdk:"resend_verification"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *senderVerificationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -116,13 +122,20 @@ For more detailed information, please see the [SendGrid documentation](https://d
 				Required:            true,
 			},
 			"verified": schema.BoolAttribute{
-				MarkdownDescription: "verified",
+				MarkdownDescription: "Whether the sender is verified. It is false until the link in the verification email sent to `from_email` is clicked.",
 				Computed:            true,
 			},
 			"locked": schema.BoolAttribute{
 				MarkdownDescription: "locked",
 				Computed:            true,
 			},
+			"resend_verification": schema.BoolAttribute{
+				MarkdownDescription: "Set to true to resend the verification email while the sender is not verified. The email is resent when the value changes to true, so set it back to false before resending again.",
+				Optional:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
 		},
 	}
 }
@@ -132,18 +145,19 @@ func (r *senderVerificationResource) Configure(ctx context.Context, req resource
 		return
 	}
 
-	client, ok := req.ProviderData.(*sendgrid.Client)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+	r.rateLimits = data.rateLimits
 }
 
 func (r *senderVerificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -153,8 +167,18 @@ func (r *senderVerificationResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-		return r.client.CreateVerifiedSenderRequest(context.TODO(), &sendgrid.InputCreateVerifiedSenderRequest{
+	ctx = withAPILogFields(ctx, "create", data.ID)
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	res, err := r.rateLimits.retryNonIdempotent(ctx, rateLimitGroupVerifiedSenders, func() (interface{}, error) {
+		return r.client.CreateVerifiedSenderRequest(ctx, &sendgrid.InputCreateVerifiedSenderRequest{
 			Nickname:    data.Nickname.ValueString(),
 			FromEmail:   data.FromEmail.ValueString(),
 			FromName:    data.FromName.ValueString(),
@@ -171,7 +195,7 @@ func (r *senderVerificationResource) Create(ctx context.Context, req resource.Cr
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Creating sender verification",
-			fmt.Sprintf("Unable to verified sender, got error: %s", err),
+			fmt.Sprintf("Unable to verified sender, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -214,6 +238,16 @@ func (r *senderVerificationResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "read", data.ID)
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	id := data.ID.ValueString()
 	verifiedSenderId, _ := strconv.ParseInt(id, 10, 64)
 	senders, err := r.client.GetVerifiedSenders(ctx, &sendgrid.InputGetVerifiedSenders{
@@ -223,7 +257,7 @@ func (r *senderVerificationResource) Read(ctx context.Context, req resource.Read
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading sender verification",
-			fmt.Sprintf("Unable to get verified sender, got error: %s", err),
+			fmt.Sprintf("Unable to get verified sender, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -252,6 +286,13 @@ func (r *senderVerificationResource) Read(ctx context.Context, req resource.Read
 	data.Verified = types.BoolValue(o.Verified)
 	data.Locked = types.BoolValue(o.Locked)
 
+	if !o.Verified {
+		resp.Diagnostics.AddWarning(
+			"Reading sender verification",
+			fmt.Sprintf("The verification of sender (id: %s) is pending. Click the link in the verification email sent to %s, or set resend_verification to resend it.", id, o.FromEmail),
+		)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -266,6 +307,16 @@ func (r *senderVerificationResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "update", data.ID)
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	id := state.ID.ValueString()
 	verifiedSenderId, _ := strconv.ParseInt(id, 10, 64)
 
@@ -308,7 +359,7 @@ func (r *senderVerificationResource) Update(ctx context.Context, req resource.Up
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Updating sender verification",
-			fmt.Sprintf("Unable to update verified sender, got error: %s", err),
+			fmt.Sprintf("Unable to update verified sender, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -328,6 +379,20 @@ func (r *senderVerificationResource) Update(ctx context.Context, req resource.Up
 	data.Verified = types.BoolValue(o.Verified)
 	data.Locked = types.BoolValue(o.Locked)
 
+	// NOTE: A verified sender has nothing left to verify, so the email is only resent while the verification is pending.
+	if data.ResendVerification.ValueBool() && !state.ResendVerification.ValueBool() && !o.Verified {
+		_, err := r.rateLimits.retryNonIdempotent(ctx, rateLimitGroupVerifiedSenders, func() (interface{}, error) {
+			return nil, r.client.ResendVerifiedSenderRequest(ctx, verifiedSenderId)
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Updating sender verification",
+				fmt.Sprintf("Unable to resend the verification email of verified sender (id: %s), got error: %s", id, sendgridErrorDetail(err)),
+			)
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -341,21 +406,33 @@ func (r *senderVerificationResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "delete", data.ID)
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	verifiedSenderId := data.ID.ValueString()
 	id, _ := strconv.ParseInt(verifiedSenderId, 10, 64)
-	_, err := retryOnRateLimit(ctx, func() (interface{}, error) {
+	_, err := r.rateLimits.retry(ctx, rateLimitGroupVerifiedSenders, func() (interface{}, error) {
 		return nil, r.client.DeleteVerifiedSender(ctx, id)
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Deleting sender verification",
-			fmt.Sprintf("Unable to delete verified sender (id: %s), got error: %s", verifiedSenderId, err),
+			fmt.Sprintf("Unable to delete verified sender (id: %s), got error: %s", verifiedSenderId, sendgridErrorDetail(err)),
 		)
 		return
 	}
 }
 
 func (r *senderVerificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = withAPILogFields(ctx, "import", types.StringValue(req.ID))
+
 	var data senderVerificationResourceModel
 
 	id := req.ID
@@ -369,7 +446,7 @@ func (r *senderVerificationResource) ImportState(ctx context.Context, req resour
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Importing sender verification",
-			fmt.Sprintf("Unable to get verified sender (id: %s), got error: %s", id, err),
+			fmt.Sprintf("Unable to get verified sender (id: %s), got error: %s", id, sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -397,6 +474,8 @@ func (r *senderVerificationResource) ImportState(ctx context.Context, req resour
 	data.ReplyToName = types.StringValue(o.ReplyToName)
 	data.Verified = types.BoolValue(o.Verified)
 	data.Locked = types.BoolValue(o.Locked)
+	data.ResendVerification = types.BoolNull()
+	data.Timeouts = nullTimeouts()
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -4,9 +4,15 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"testing"
 
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
@@ -81,3 +87,153 @@ resource "sendgrid_sender_verification" "test" {
 }
 `, nickname, reply_to, from_name, from_email, address, address2, city, country)
 }
+
+// testSenderVerification returns a sender with the given verification status as SendGrid responds it.
+func testSenderVerification(verified bool) map[string]any {
+	return map[string]any{
+		"id":         1,
+		"nickname":   "support",
+		"from_email": "support@example.com",
+		"from_name":  "Support",
+		"reply_to":   "support@example.com",
+		"address":    "1-1-1 Chiyoda",
+		"city":       "Tokyo",
+		"country":    "JPN",
+		"verified":   verified,
+		"locked":     false,
+	}
+}
+
+// testSenderVerificationState returns the state of the sender returned by testSenderVerification.
+func testSenderVerificationState(t *testing.T, r *senderVerificationResource, resendVerification bool) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	s := schemaResp.Schema
+
+	state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	diags := state.Set(ctx, &senderVerificationResourceModel{
+		ID:                 types.StringValue("1"),
+		Nickname:           types.StringValue("support"),
+		FromEmail:          types.StringValue("support@example.com"),
+		FromName:           types.StringValue("Support"),
+		ReplyTo:            types.StringValue("support@example.com"),
+		ReplyToName:        types.StringValue(""),
+		Address:            types.StringValue("1-1-1 Chiyoda"),
+		Address2:           types.StringValue(""),
+		State:              types.StringValue(""),
+		City:               types.StringValue("Tokyo"),
+		Zip:                types.StringValue(""),
+		Country:            types.StringValue("JPN"),
+		Verified:           types.BoolValue(true),
+		Locked:             types.BoolValue(false),
+		ResendVerification: types.BoolValue(resendVerification),
+		Timeouts:           nullTimeouts(),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	return state
+}
+
+func TestSenderVerificationResourceReadPending(t *testing.T) {
+	ctx := context.Background()
+	rt := newMockTransport()
+	rt.Handle(http.MethodGet, "/v3/verified_senders", func(_ *http.Request) (int, any) {
+		return http.StatusOK, map[string]any{"results": []map[string]any{testSenderVerification(false)}}
+	})
+	r := &senderVerificationResource{client: newMockClient(rt)}
+
+	state := testSenderVerificationState(t, r, false)
+	resp := &fwresource.ReadResponse{State: state}
+	r.Read(ctx, fwresource.ReadRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if resp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("got %v, want a warning that the verification is pending", resp.Diagnostics)
+	}
+
+	var got senderVerificationResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	if got.Verified.ValueBool() {
+		t.Errorf("verified = %s, want false", got.Verified)
+	}
+	if got.ID.ValueString() != "1" || got.FromEmail.ValueString() != "support@example.com" {
+		t.Errorf("got id %s and from_email %s, want the pending sender", got.ID, got.FromEmail)
+	}
+}
+
+func TestSenderVerificationResourceUpdateResendsVerification(t *testing.T) {
+	cases := map[string]struct {
+		verified   bool
+		prior      bool
+		resend     bool
+		wantResend bool
+	}{
+		"pending and set":         {verified: false, prior: false, resend: true, wantResend: true},
+		"pending and already set": {verified: false, prior: true, resend: true, wantResend: false},
+		"pending and unset":       {verified: false, prior: false, resend: false, wantResend: false},
+		"verified and set":        {verified: true, prior: false, resend: true, wantResend: false},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			rt := newMockTransport()
+			rt.Handle(http.MethodPatch, "/v3/verified_senders/1", func(_ *http.Request) (int, any) {
+				return http.StatusOK, testSenderVerification(c.verified)
+			})
+			resent := 0
+			rt.Handle(http.MethodPost, "/v3/verified_senders/resend/1", func(_ *http.Request) (int, any) {
+				resent++
+				return http.StatusNoContent, nil
+			})
+			r := &senderVerificationResource{client: newMockClient(rt)}
+
+			state := testSenderVerificationState(t, r, c.prior)
+			plan := testSenderVerificationState(t, r, c.resend)
+			resp := &fwresource.UpdateResponse{State: state}
+			r.Update(ctx, fwresource.UpdateRequest{Plan: tfsdk.Plan(plan), State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if got := resent > 0; got != c.wantResend {
+				t.Errorf("resent %t, want %t", got, c.wantResend)
+			}
+		})
+	}
+}
+
+func TestSenderVerificationResourceImportState(t *testing.T) {
+	ctx := context.Background()
+	rt := newMockTransport()
+	rt.Handle(http.MethodGet, "/v3/verified_senders", func(_ *http.Request) (int, any) {
+		return http.StatusOK, map[string]any{"results": []map[string]any{testSenderVerification(true)}}
+	})
+	r := &senderVerificationResource{client: newMockClient(rt)}
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	s := schemaResp.Schema
+
+	resp := &fwresource.ImportStateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+	r.ImportState(ctx, fwresource.ImportStateRequest{ID: "1"}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var got senderVerificationResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if got.ID.ValueString() != "1" || !got.Verified.ValueBool() {
+		t.Errorf("got id %s and verified %s, want the imported verified sender", got.ID, got.Verified)
+	}
+	if !got.ResendVerification.IsNull() || !got.Timeouts.IsNull() {
+		t.Errorf("got resend_verification %s and timeouts %s, want both null", got.ResendVerification, got.Timeouts)
+	}
+}
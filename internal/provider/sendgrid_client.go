@@ -0,0 +1,337 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/i10416/sendgrid"
+)
+
+// sendgridClient adds the SendGrid API calls that the client does not implement.
+// They are built on the client's NewRequest and Do, so they share its authentication, subuser and transport.
+type sendgridClient struct {
+	*sendgrid.Client
+}
+
+type outputGetCustomFields struct {
+	CustomFields []sendgrid.CustomField `json:"custom_fields"`
+}
+
+// GetCustomFields lists every custom field defined on the account.
+func (c sendgridClient) GetCustomFields(ctx context.Context) ([]sendgrid.CustomField, error) {
+	req, err := c.NewRequest(http.MethodGet, "/contactdb/custom_fields", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := outputGetCustomFields{}
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+
+	return r.CustomFields, nil
+}
+
+type outputGetAllowlistRules struct {
+	Result []sendgrid.AllowlistRule `json:"result"`
+}
+
+// GetAllowlistRules lists every rule in the IP allowlist.
+func (c sendgridClient) GetAllowlistRules(ctx context.Context) ([]sendgrid.AllowlistRule, error) {
+	req, err := c.NewRequest(http.MethodGet, "/access_settings/whitelist", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := outputGetAllowlistRules{}
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+
+	return r.Result, nil
+}
+
+// GetIPAddressesPage lists one page of the IP addresses of the account.
+func (c sendgridClient) GetIPAddressesPage(ctx context.Context, limit, offset int) ([]sendgrid.IPAddress, error) {
+	req, err := c.NewRequest(http.MethodGet, fmt.Sprintf("/ips?limit=%d&offset=%d", limit, offset), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ips []sendgrid.IPAddress
+	if err := c.Do(ctx, req, &ips); err != nil {
+		return nil, err
+	}
+
+	return ips, nil
+}
+
+// ipWarmup is an IP address in warmup and the Unix time its warmup started at.
+type ipWarmup struct {
+	IP        string `json:"ip"`
+	StartDate int64  `json:"start_date"`
+}
+
+// StartIPWarmup puts ip into warmup. Unlike the client's StartIPWarmup, it sends the IP address in the body
+// as SendGrid documents and returns the start date of the warmup.
+func (c sendgridClient) StartIPWarmup(ctx context.Context, ip string) (*ipWarmup, error) {
+	req, err := c.NewRequest(http.MethodPost, "/ips/warmup", map[string]string{"ip": ip})
+	if err != nil {
+		return nil, err
+	}
+
+	var warmups []ipWarmup
+	if err := c.Do(ctx, req, &warmups); err != nil {
+		return nil, err
+	}
+	return findIPWarmup(warmups, ip), nil
+}
+
+// GetIPWarmup reads the warmup of ip. It returns nil if ip is not in warmup, which SendGrid answers
+// with either an empty list or 404 Not Found.
+func (c sendgridClient) GetIPWarmup(ctx context.Context, ip string) (*ipWarmup, error) {
+	req, err := c.NewRequest(http.MethodGet, "/ips/warmup/"+url.PathEscape(ip), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var warmups []ipWarmup
+	if err := c.Do(ctx, req, &warmups); err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return findIPWarmup(warmups, ip), nil
+}
+
+// StopIPWarmup takes ip out of warmup.
+func (c sendgridClient) StopIPWarmup(ctx context.Context, ip string) error {
+	req, err := c.NewRequest(http.MethodDelete, "/ips/warmup/"+url.PathEscape(ip), nil)
+	if err != nil {
+		return err
+	}
+	return c.Do(ctx, req, nil)
+}
+
+func findIPWarmup(warmups []ipWarmup, ip string) *ipWarmup {
+	for _, w := range warmups {
+		if w.IP == ip {
+			return &w
+		}
+	}
+	return nil
+}
+
+// globalUnsubscribe is an address on the global unsubscribe list.
+type globalUnsubscribe struct {
+	Email   string `json:"email"`
+	Created int64  `json:"created"`
+}
+
+// GetGlobalUnsubscribes lists the addresses on the global unsubscribe list matching opts.
+func (c sendgridClient) GetGlobalUnsubscribes(ctx context.Context, opts *sendgrid.SuppressionListOptions) ([]globalUnsubscribe, error) {
+	path := "/suppression/unsubscribes"
+	if opts != nil {
+		var err error
+		path, err = c.AddOptions(path, opts)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	req, err := c.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var unsubscribes []globalUnsubscribe
+	if err := c.Do(ctx, req, &unsubscribes); err != nil {
+		return nil, err
+	}
+
+	return unsubscribes, nil
+}
+
+type inputAddGlobalSuppressions struct {
+	RecipientEmails []string `json:"recipient_emails"`
+}
+
+// AddGlobalSuppressions adds emails to the global unsubscribe list.
+// Adding an address that is already on the list succeeds without changing it.
+func (c sendgridClient) AddGlobalSuppressions(ctx context.Context, emails []string) error {
+	req, err := c.NewRequest(http.MethodPost, "/asm/suppressions/global", &inputAddGlobalSuppressions{RecipientEmails: emails})
+	if err != nil {
+		return err
+	}
+
+	return c.Do(ctx, req, nil)
+}
+
+// DeleteGlobalSuppression removes email from the global unsubscribe list.
+func (c sendgridClient) DeleteGlobalSuppression(ctx context.Context, email string) error {
+	req, err := c.NewRequest(http.MethodDelete, "/asm/suppressions/global/"+url.PathEscape(email), nil)
+	if err != nil {
+		return err
+	}
+
+	return c.Do(ctx, req, nil)
+}
+
+type category struct {
+	Category string `json:"category"`
+}
+
+// GetCategoriesPage lists one page of the categories of the account starting with prefix.
+func (c sendgridClient) GetCategoriesPage(ctx context.Context, prefix string, limit, offset int) ([]string, error) {
+	q := url.Values{}
+	q.Set("limit", fmt.Sprint(limit))
+	q.Set("offset", fmt.Sprint(offset))
+	if prefix != "" {
+		q.Set("category", prefix)
+	}
+	req, err := c.NewRequest(http.MethodGet, "/categories?"+q.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var categories []category
+	if err := c.Do(ctx, req, &categories); err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(categories))
+	for _, c := range categories {
+		names = append(names, c.Category)
+	}
+	return names, nil
+}
+
+// GetScopes lists the scopes granted to the API key the client authenticates with.
+func (c sendgridClient) GetScopes(ctx context.Context) ([]string, error) {
+	req, err := c.NewRequest(http.MethodGet, "/scopes", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r struct {
+		Scopes []string `json:"scopes"`
+	}
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r.Scopes, nil
+}
+
+// getMailSetting reads the mail setting name, such as address_whitelist, into v.
+func (c sendgridClient) getMailSetting(ctx context.Context, name string, v interface{}) error {
+	req, err := c.NewRequest(http.MethodGet, "/mail_settings/"+name, nil)
+	if err != nil {
+		return err
+	}
+
+	return c.Do(ctx, req, v)
+}
+
+// updateMailSetting updates the mail setting name with input and reads the updated setting into v.
+func (c sendgridClient) updateMailSetting(ctx context.Context, name string, input, v interface{}) error {
+	req, err := c.NewRequest(http.MethodPatch, "/mail_settings/"+name, input)
+	if err != nil {
+		return err
+	}
+
+	return c.Do(ctx, req, v)
+}
+
+// addressWhitelist is the address allowlist mail setting, which delivers to the listed addresses and domains
+// even when they are on a suppression list.
+type addressWhitelist struct {
+	Enabled bool     `json:"enabled"`
+	List    []string `json:"list"`
+}
+
+type inputUpdateAddressWhitelist struct {
+	Enabled bool `json:"enabled"`
+	// List replaces every listed address and domain unless nil.
+	List *[]string `json:"list,omitempty"`
+}
+
+// GetAddressWhitelist reads the address allowlist mail setting.
+func (c sendgridClient) GetAddressWhitelist(ctx context.Context) (*addressWhitelist, error) {
+	r := addressWhitelist{}
+	if err := c.getMailSetting(ctx, "address_whitelist", &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// UpdateAddressWhitelist updates the address allowlist mail setting.
+func (c sendgridClient) UpdateAddressWhitelist(ctx context.Context, input *inputUpdateAddressWhitelist) (*addressWhitelist, error) {
+	r := addressWhitelist{}
+	if err := c.updateMailSetting(ctx, "address_whitelist", input, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// forwardBounce is the forward bounce mail setting, which forwards bounce reports to email.
+type forwardBounce struct {
+	Enabled bool   `json:"enabled"`
+	Email   string `json:"email"`
+}
+
+// GetForwardBounce reads the forward bounce mail setting.
+func (c sendgridClient) GetForwardBounce(ctx context.Context) (*forwardBounce, error) {
+	r := forwardBounce{}
+	if err := c.getMailSetting(ctx, "forward_bounce", &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// UpdateForwardBounce updates the forward bounce mail setting.
+func (c sendgridClient) UpdateForwardBounce(ctx context.Context, input *forwardBounce) (*forwardBounce, error) {
+	r := forwardBounce{}
+	if err := c.updateMailSetting(ctx, "forward_bounce", input, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// spamCheck is the spam checker mail setting, which drops email scoring at least MaxScore on SpamAssassin
+// and posts it to URL.
+type spamCheck struct {
+	Enabled  bool   `json:"enabled"`
+	URL      string `json:"url"`
+	MaxScore int64  `json:"max_score"`
+}
+
+// inputUpdateSpamCheck is the spam checker setting to update. The url and max_score are kept if omitted.
+type inputUpdateSpamCheck struct {
+	Enabled  bool   `json:"enabled"`
+	URL      string `json:"url,omitempty"`
+	MaxScore int64  `json:"max_score,omitempty"`
+}
+
+// GetSpamCheck reads the spam checker mail setting.
+func (c sendgridClient) GetSpamCheck(ctx context.Context) (*spamCheck, error) {
+	r := spamCheck{}
+	if err := c.getMailSetting(ctx, "spam_check", &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// UpdateSpamCheck updates the spam checker mail setting.
+func (c sendgridClient) UpdateSpamCheck(ctx context.Context, input *inputUpdateSpamCheck) (*spamCheck, error) {
+	r := spamCheck{}
+	if err := c.updateMailSetting(ctx, "spam_check", input, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/i10416/sendgrid"
+)
+
+func TestSendgridClientGetCustomFields(t *testing.T) {
+	ctx := context.Background()
+	rt, _ := newMockCustomFieldTransport()
+	client := sendgridClient{newMockClient(rt)}
+
+	for _, name := range []string{"favorite_color", "shoe_size"} {
+		if _, err := client.CreateCustomField(ctx, &sendgrid.InputCreateCustomField{Name: name, Type: "text"}); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	fields, err := client.GetCustomFields(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(fields) != 2 || fields[0].Name != "favorite_color" || fields[1].ID != 2 {
+		t.Errorf("got %+v, want both custom fields", fields)
+	}
+
+	req := rt.Requests()[len(rt.Requests())-1]
+	if req.Method != http.MethodGet || req.URL.Path != "/v3/contactdb/custom_fields" {
+		t.Errorf("got %s %s, want GET /v3/contactdb/custom_fields", req.Method, req.URL.Path)
+	}
+}
+
+func TestSendgridClientGetAllowlistRules(t *testing.T) {
+	rt := newMockTransport()
+	rt.Handle(http.MethodGet, "/v3/access_settings/whitelist", func(_ *http.Request) (int, any) {
+		return http.StatusOK, map[string]any{"result": []map[string]any{
+			{"id": 1, "ip": "192.0.2.1/32", "created_at": 1700000000, "updated_at": 1700000000},
+			{"id": 2, "ip": "198.51.100.0/24", "created_at": 1700000000, "updated_at": 1700000000},
+		}}
+	})
+
+	rules, err := sendgridClient{newMockClient(rt)}.GetAllowlistRules(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	want := []sendgrid.AllowlistRule{{ID: 1, Ip: "192.0.2.1/32"}, {ID: 2, Ip: "198.51.100.0/24"}}
+	if !slices.Equal(rules, want) {
+		t.Errorf("got %+v, want %+v", rules, want)
+	}
+}
+
+func TestSendgridClientGlobalSuppressions(t *testing.T) {
+	ctx := context.Background()
+	rt, _ := newMockGlobalUnsubscribeTransport("user@example.com")
+	client := sendgridClient{newMockClient(rt)}
+
+	if err := client.AddGlobalSuppressions(ctx, []string{"user@example.com"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	got, err := client.GetGlobalUnsubscribes(ctx, &sendgrid.SuppressionListOptions{Email: "user@example.com"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if want := []globalUnsubscribe{{Email: "user@example.com", Created: 1700000001}}; !slices.Equal(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+	if req := rt.Requests()[len(rt.Requests())-1]; req.URL.Query().Get("email") != "user@example.com" {
+		t.Errorf("got query %s, want the email filter", req.URL.RawQuery)
+	}
+
+	if err := client.DeleteGlobalSuppression(ctx, "user@example.com"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := client.DeleteGlobalSuppression(ctx, "user@example.com"); !isNotFoundError(err) {
+		t.Errorf("got error %v, want a not found error", err)
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// diffSets compares the desired and current members of a set-managed resource.
+// toAdd holds the items only in desired and toRemove holds the items only in current,
+// both in the order they first appear and without duplicates.
+func diffSets[T comparable](desired, current []T) (toAdd, toRemove []T) {
+	inDesired := make(map[T]struct{}, len(desired))
+	for _, v := range desired {
+		inDesired[v] = struct{}{}
+	}
+	inCurrent := make(map[T]struct{}, len(current))
+	for _, v := range current {
+		inCurrent[v] = struct{}{}
+	}
+
+	seen := map[T]struct{}{}
+	for _, v := range desired {
+		if _, ok := inCurrent[v]; ok {
+			continue
+		}
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		toAdd = append(toAdd, v)
+	}
+
+	seen = map[T]struct{}{}
+	for _, v := range current {
+		if _, ok := inDesired[v]; ok {
+			continue
+		}
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		toRemove = append(toRemove, v)
+	}
+
+	return toAdd, toRemove
+}
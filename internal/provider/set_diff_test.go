@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDiffSets(t *testing.T) {
+	cases := []struct {
+		name         string
+		desired      []string
+		current      []string
+		wantToAdd    []string
+		wantToRemove []string
+	}{
+		{
+			name: "both empty",
+		},
+		{
+			name:      "empty current",
+			desired:   []string{"a", "b"},
+			wantToAdd: []string{"a", "b"},
+		},
+		{
+			name:         "empty desired",
+			current:      []string{"a", "b"},
+			wantToRemove: []string{"a", "b"},
+		},
+		{
+			name:         "disjoint",
+			desired:      []string{"a", "b"},
+			current:      []string{"c", "d"},
+			wantToAdd:    []string{"a", "b"},
+			wantToRemove: []string{"c", "d"},
+		},
+		{
+			name:         "overlapping",
+			desired:      []string{"a", "b", "c"},
+			current:      []string{"b", "c", "d"},
+			wantToAdd:    []string{"a"},
+			wantToRemove: []string{"d"},
+		},
+		{
+			name:    "identical in different order",
+			desired: []string{"a", "b", "c"},
+			current: []string{"c", "a", "b"},
+		},
+		{
+			name:         "duplicates",
+			desired:      []string{"a", "a", "b"},
+			current:      []string{"c", "c"},
+			wantToAdd:    []string{"a", "b"},
+			wantToRemove: []string{"c"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			toAdd, toRemove := diffSets(c.desired, c.current)
+			if !slices.Equal(toAdd, c.wantToAdd) {
+				t.Errorf("toAdd: got %v, want %v", toAdd, c.wantToAdd)
+			}
+			if !slices.Equal(toRemove, c.wantToRemove) {
+				t.Errorf("toRemove: got %v, want %v", toRemove, c.wantToRemove)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// singletonImportID is the import ID of resources managing account-wide settings, which have no natural id.
+// An empty import ID is also accepted for backward compatibility.
+const singletonImportID = "singleton"
+
+// validateSingletonImportID returns an error unless id is an import ID of a singleton resource.
+func validateSingletonImportID(resourceType, id string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if id != "" && id != singletonImportID {
+		diags.AddError(
+			fmt.Sprintf("Importing %s", resourceType),
+			fmt.Sprintf(
+				"%s manages account-wide settings and has no id. Use %q as the import ID, got: %q",
+				resourceType, singletonImportID, id,
+			),
+		)
+	}
+	return diags
+}
+
+// singletonResource implements the operations of a resource managing account-wide settings, which always exist.
+// Create and update send the planned settings with set, read and import get the current settings with get,
+// and delete calls disable, or only removes the resource from state when disable is nil.
+// M is the resource model, which get and set convert from and to the SendGrid API.
+type singletonResource[M any] struct {
+	// name is the name of the settings in diagnostics. Example: click tracking settings
+	name string
+	// typeName is the resource type name in import diagnostics. Example: sendgrid_click_tracking_settings
+	typeName string
+
+	get     func(ctx context.Context) (M, error)
+	set     func(ctx context.Context, plan M) (M, error)
+	disable func(ctx context.Context) error
+}
+
+func (s singletonResource[M]) create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan M
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAPILogFields(ctx, "create", nil)
+
+	o, err := s.set(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Creating %s", s.name),
+			fmt.Sprintf("Unable to update %s, got error: %s", s.name, sendgridErrorDetail(err)),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &o)...)
+}
+
+func (s singletonResource[M]) read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = withAPILogFields(ctx, "read", nil)
+
+	o, err := s.get(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Reading %s", s.name),
+			fmt.Sprintf("Unable to read %s, got error: %s", s.name, sendgridErrorDetail(err)),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &o)...)
+}
+
+func (s singletonResource[M]) update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan M
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAPILogFields(ctx, "update", nil)
+
+	o, err := s.set(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Updating %s", s.name),
+			fmt.Sprintf("Unable to update %s, got error: %s", s.name, sendgridErrorDetail(err)),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &o)...)
+}
+
+func (s singletonResource[M]) delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// NOTE: Settings without disable are left as they are, because SendGrid has no way to delete them.
+	if s.disable == nil {
+		return
+	}
+
+	ctx = withAPILogFields(ctx, "delete", nil)
+
+	if err := s.disable(ctx); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Deleting %s", s.name),
+			fmt.Sprintf("Unable to disable %s, got error: %s", s.name, sendgridErrorDetail(err)),
+		)
+		return
+	}
+}
+
+func (s singletonResource[M]) importState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = withAPILogFields(ctx, "import", types.StringValue(req.ID))
+
+	resp.Diagnostics.Append(validateSingletonImportID(s.typeName, req.ID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	o, err := s.get(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Importing %s", s.name),
+			fmt.Sprintf("Unable to read %s, got error: %s", s.name, sendgridErrorDetail(err)),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &o)...)
+}
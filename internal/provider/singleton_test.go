@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestValidateSingletonImportID(t *testing.T) {
+	cases := []struct {
+		id      string
+		wantErr bool
+	}{
+		{id: "", wantErr: false},
+		{id: "singleton", wantErr: false},
+		{id: "1234", wantErr: true},
+		{id: "Singleton", wantErr: true},
+	}
+
+	for _, c := range cases {
+		if got := validateSingletonImportID("sendgrid_enforce_tls", c.id).HasError(); got != c.wantErr {
+			t.Errorf("validateSingletonImportID(%q) has error %t, want %t", c.id, got, c.wantErr)
+		}
+	}
+}
+
+type testSingletonModel struct {
+	Enabled types.Bool `tfsdk:"enabled"`
+}
+
+var testSingletonSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"enabled": schema.BoolAttribute{Optional: true, Computed: true},
+	},
+}
+
+func testSingletonObject(enabled bool) tftypes.Value {
+	return tftypes.NewValue(
+		tftypes.Object{AttributeTypes: map[string]tftypes.Type{"enabled": tftypes.Bool}},
+		map[string]tftypes.Value{"enabled": tftypes.NewValue(tftypes.Bool, enabled)},
+	)
+}
+
+func testSingletonState() tfsdk.State {
+	return tfsdk.State{Schema: testSingletonSchema, Raw: tftypes.NewValue(testSingletonSchema.Type().TerraformType(context.Background()), nil)}
+}
+
+// testSingleton returns a singletonResource whose settings are stored in enabled.
+func testSingleton(enabled *bool) singletonResource[testSingletonModel] {
+	return singletonResource[testSingletonModel]{
+		name:     "test settings",
+		typeName: "sendgrid_test_settings",
+		get: func(ctx context.Context) (testSingletonModel, error) {
+			return testSingletonModel{Enabled: types.BoolValue(*enabled)}, nil
+		},
+		set: func(ctx context.Context, plan testSingletonModel) (testSingletonModel, error) {
+			*enabled = plan.Enabled.ValueBool()
+			return testSingletonModel{Enabled: types.BoolValue(*enabled)}, nil
+		},
+	}
+}
+
+func TestSingletonResourceCreateAndUpdate(t *testing.T) {
+	ctx := context.Background()
+	enabled := false
+	s := testSingleton(&enabled)
+
+	createResp := &resource.CreateResponse{State: testSingletonState()}
+	s.create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: testSingletonSchema, Raw: testSingletonObject(true)}}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", createResp.Diagnostics)
+	}
+	var got testSingletonModel
+	createResp.Diagnostics.Append(createResp.State.Get(ctx, &got)...)
+	if !enabled || !got.Enabled.ValueBool() {
+		t.Errorf("create: settings enabled %t, state enabled %s, want both true", enabled, got.Enabled)
+	}
+
+	updateResp := &resource.UpdateResponse{State: testSingletonState()}
+	s.update(ctx, resource.UpdateRequest{Plan: tfsdk.Plan{Schema: testSingletonSchema, Raw: testSingletonObject(false)}}, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", updateResp.Diagnostics)
+	}
+	updateResp.Diagnostics.Append(updateResp.State.Get(ctx, &got)...)
+	if enabled || got.Enabled.ValueBool() {
+		t.Errorf("update: settings enabled %t, state enabled %s, want both false", enabled, got.Enabled)
+	}
+}
+
+func TestSingletonResourceReadError(t *testing.T) {
+	s := singletonResource[testSingletonModel]{
+		name: "test settings",
+		get: func(ctx context.Context) (testSingletonModel, error) {
+			return testSingletonModel{}, errors.New("access forbidden")
+		},
+	}
+
+	resp := &resource.ReadResponse{State: testSingletonState()}
+	s.read(context.Background(), resource.ReadRequest{}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error")
+	}
+	if detail := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, "access forbidden") {
+		t.Errorf("error detail %q does not contain the cause", detail)
+	}
+}
+
+func TestSingletonResourceDelete(t *testing.T) {
+	ctx := context.Background()
+
+	enabled := true
+	s := testSingleton(&enabled)
+	resp := &resource.DeleteResponse{}
+	s.delete(ctx, resource.DeleteRequest{}, resp)
+	if resp.Diagnostics.HasError() || !enabled {
+		t.Errorf("delete without disable changed the settings: enabled %t, diagnostics %v", enabled, resp.Diagnostics)
+	}
+
+	s.disable = func(ctx context.Context) error {
+		enabled = false
+		return nil
+	}
+	s.delete(ctx, resource.DeleteRequest{}, resp)
+	if resp.Diagnostics.HasError() || enabled {
+		t.Errorf("delete with disable did not disable the settings: enabled %t, diagnostics %v", enabled, resp.Diagnostics)
+	}
+}
+
+func TestSingletonResourceImportState(t *testing.T) {
+	ctx := context.Background()
+	enabled := true
+	s := testSingleton(&enabled)
+
+	resp := &resource.ImportStateResponse{State: testSingletonState()}
+	s.importState(ctx, resource.ImportStateRequest{ID: "1234"}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected an error for an invalid import ID")
+	}
+
+	resp = &resource.ImportStateResponse{State: testSingletonState()}
+	s.importState(ctx, resource.ImportStateRequest{ID: singletonImportID}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	var got testSingletonModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	if !got.Enabled.ValueBool() {
+		t.Errorf("imported enabled %s, want true", got.Enabled)
+	}
+}
@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/i10416/sendgrid"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &spamCheckSettingsResource{}
+var _ resource.ResourceWithImportState = &spamCheckSettingsResource{}
+var _ resource.ResourceWithValidateConfig = &spamCheckSettingsResource{}
+
+func newSpamCheckSettingsResource() resource.Resource {
+	return &spamCheckSettingsResource{}
+}
+
+type spamCheckSettingsResource struct {
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
+}
+
+type spamCheckSettingsResourceModel struct {
+	Enabled  types.Bool   `tfsdk:"enabled"`
+	URL      types.String `tfsdk:"url"`
+	MaxScore types.Int64  `tfsdk:"max_score"`
+}
+
+func (r *spamCheckSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_spam_check_settings"
+}
+
+func (r *spamCheckSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Manages the spam checker mail setting, which drops email that SpamAssassin scores at or above ` + "`max_score`" + ` and posts it to ` + "`url`" + `.
+
+Destroying this resource disables the setting.
+
+For more detailed information, please see the [SendGrid documentation](https://www.twilio.com/docs/sendgrid/ui/account-and-settings/mail#spam-checker).
+		`,
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Indicates if the spam checker is enabled. `url` is required when it is `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The absolute http or https URL that email marked as spam is posted to. The current URL is kept if omitted.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"max_score": schema.Int64Attribute{
+				MarkdownDescription: "The SpamAssassin score from 1 to 10 at or above which email is marked as spam. The lower the score, the stricter the check. Defaults to `5`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(5),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 10),
+				},
+			},
+		},
+	}
+}
+
+func (r *spamCheckSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+	r.rateLimits = data.rateLimits
+}
+
+func (r *spamCheckSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var enabled types.Bool
+	var u types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("enabled"), &enabled)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("url"), &u)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !u.IsNull() && !u.IsUnknown() {
+		parsed, err := url.Parse(u.ValueString())
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("url"),
+				"Invalid spam check URL",
+				fmt.Sprintf("url must be an absolute http or https URL such as https://example.com/spam, got: %q.", u.ValueString()),
+			)
+		}
+	}
+
+	if enabled.ValueBool() && u.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("url"),
+			"Missing spam check URL",
+			"url is required when enabled is true.",
+		)
+	}
+}
+
+func (r *spamCheckSettingsResource) singleton() singletonResource[spamCheckSettingsResourceModel] {
+	client := sendgridClient{r.client}
+	update := func(ctx context.Context, input *inputUpdateSpamCheck) (spamCheckSettingsResourceModel, error) {
+		// NOTE: Re-execute after the re-executable time has elapsed when a rate limit occurs
+		res, err := r.rateLimits.retry(ctx, rateLimitGroupMailSettings, func() (interface{}, error) {
+			return client.UpdateSpamCheck(ctx, input)
+		})
+		if err != nil {
+			return spamCheckSettingsResourceModel{}, err
+		}
+		o, ok := res.(*spamCheck)
+		if !ok {
+			return spamCheckSettingsResourceModel{}, fmt.Errorf("failed to assert type *spamCheck")
+		}
+		return spamCheckSettingsModel(o), nil
+	}
+
+	return singletonResource[spamCheckSettingsResourceModel]{
+		name:     "spam check settings",
+		typeName: "sendgrid_spam_check_settings",
+		get: func(ctx context.Context) (spamCheckSettingsResourceModel, error) {
+			// NOTE: Re-execute after the re-executable time has elapsed when a rate limit occurs
+			res, err := r.rateLimits.retry(ctx, rateLimitGroupMailSettings, func() (interface{}, error) {
+				return client.GetSpamCheck(ctx)
+			})
+			if err != nil {
+				return spamCheckSettingsResourceModel{}, err
+			}
+			o, ok := res.(*spamCheck)
+			if !ok {
+				return spamCheckSettingsResourceModel{}, fmt.Errorf("failed to assert type *spamCheck")
+			}
+			return spamCheckSettingsModel(o), nil
+		},
+		set: func(ctx context.Context, plan spamCheckSettingsResourceModel) (spamCheckSettingsResourceModel, error) {
+			// NOTE: An omitted url is unknown in the plan, so it is not sent and SendGrid keeps the current one.
+			input := &inputUpdateSpamCheck{
+				Enabled:  plan.Enabled.ValueBool(),
+				MaxScore: plan.MaxScore.ValueInt64(),
+			}
+			if !plan.URL.IsUnknown() {
+				input.URL = plan.URL.ValueString()
+			}
+			return update(ctx, input)
+		},
+		disable: func(ctx context.Context) error {
+			_, err := update(ctx, &inputUpdateSpamCheck{Enabled: false})
+			return err
+		},
+	}
+}
+
+func (r *spamCheckSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.singleton().create(ctx, req, resp)
+}
+
+func (r *spamCheckSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.singleton().read(ctx, req, resp)
+}
+
+func (r *spamCheckSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.singleton().update(ctx, req, resp)
+}
+
+func (r *spamCheckSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.singleton().delete(ctx, req, resp)
+}
+
+func (r *spamCheckSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.singleton().importState(ctx, req, resp)
+}
+
+func spamCheckSettingsModel(o *spamCheck) spamCheckSettingsResourceModel {
+	return spamCheckSettingsResourceModel{
+		Enabled:  types.BoolValue(o.Enabled),
+		URL:      types.StringValue(o.URL),
+		MaxScore: types.Int64Value(o.MaxScore),
+	}
+}
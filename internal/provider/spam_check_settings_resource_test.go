@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestSpamCheckSettingsResourceWithMockTransport(t *testing.T) {
+	resourceName := "sendgrid_spam_check_settings.test"
+	rt, _ := newMockMailSettingTransport("spam_check", map[string]any{"enabled": false, "url": "", "max_score": 5})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactoriesWithTransport(rt),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testSpamCheckSettingsResourceMockConfig(`
+	enabled   = true
+	url       = "https://example.com/spam"
+	max_score = 3
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "url", "https://example.com/spam"),
+					resource.TestCheckResourceAttr(resourceName, "max_score", "3"),
+				),
+			},
+			// Disabling keeps the url
+			{
+				Config: testSpamCheckSettingsResourceMockConfig(`
+	enabled = false
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "url", "https://example.com/spam"),
+					resource.TestCheckResourceAttr(resourceName, "max_score", "5"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     singletonImportID,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestSpamCheckSettingsResourceValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	r := &spamCheckSettingsResource{}
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	s := schemaResp.Schema
+	typ := s.Type().TerraformType(ctx)
+
+	cases := []struct {
+		name    string
+		enabled any
+		url     any
+		wantErr string
+	}{
+		{name: "enabled with a url", enabled: true, url: "https://example.com/spam"},
+		{name: "enabled without a url", enabled: true, wantErr: "Missing spam check URL"},
+		{name: "enabled with an unknown url", enabled: true, url: tftypes.UnknownValue},
+		{name: "disabled without a url", enabled: false},
+		{name: "omitted enabled without a url"},
+		{name: "relative url", enabled: true, url: "/spam", wantErr: "Invalid spam check URL"},
+		{name: "url without a host", enabled: false, url: "https://", wantErr: "Invalid spam check URL"},
+		{name: "unsupported scheme", enabled: true, url: "ftp://example.com/spam", wantErr: "Invalid spam check URL"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			config := tfsdk.Config{Schema: s, Raw: tftypes.NewValue(typ, map[string]tftypes.Value{
+				"enabled":   tftypes.NewValue(tftypes.Bool, c.enabled),
+				"url":       tftypes.NewValue(tftypes.String, c.url),
+				"max_score": tftypes.NewValue(tftypes.Number, nil),
+			})}
+			resp := &fwresource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, fwresource.ValidateConfigRequest{Config: config}, resp)
+
+			if c.wantErr == "" {
+				if resp.Diagnostics.HasError() {
+					t.Errorf("unexpected error: %v", resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.ErrorsCount() != 1 || resp.Diagnostics.Errors()[0].Summary() != c.wantErr {
+				t.Errorf("got %v, want a %q error", resp.Diagnostics, c.wantErr)
+			}
+		})
+	}
+}
+
+func TestSpamCheckSettingsSet(t *testing.T) {
+	ctx := context.Background()
+	rt, setting := newMockMailSettingTransport("spam_check", map[string]any{"enabled": true, "url": "https://example.com/spam", "max_score": 5})
+	s := (&spamCheckSettingsResource{client: newMockClient(rt)}).singleton()
+
+	// An unknown url is not sent, so the current one is kept.
+	got, err := s.set(ctx, spamCheckSettingsResourceModel{Enabled: types.BoolValue(true), URL: types.StringUnknown(), MaxScore: types.Int64Value(8)})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	want := spamCheckSettingsResourceModel{Enabled: types.BoolValue(true), URL: types.StringValue("https://example.com/spam"), MaxScore: types.Int64Value(8)}
+	if got != want {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+	if err := s.disable(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if setting.get("enabled") != false || setting.get("url") != "https://example.com/spam" {
+		t.Errorf("got enabled %v and url %v after disable, want it disabled with the url kept", setting.get("enabled"), setting.get("url"))
+	}
+}
+
+func testSpamCheckSettingsResourceMockConfig(attributes string) string {
+	return fmt.Sprintf(`
+provider "sendgrid" {
+	api_key = "SG.test"
+}
+
+resource "sendgrid_spam_check_settings" "test" {%s}
+`, attributes)
+}
@@ -25,7 +25,8 @@ func newSSOCertificateDataSource() datasource.DataSource {
 }
 
 type ssoCertificateDataSource struct {
-	client *sendgrid.Client
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
 }
 
 type ssoCertificateDataSourceModel struct {
@@ -46,16 +47,17 @@ func (d *ssoCertificateDataSource) Configure(ctx context.Context, req datasource
 		return
 	}
 
-	client, ok := req.ProviderData.(*sendgrid.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = data.client
+	d.rateLimits = data.rateLimits
 }
 
 func (d *ssoCertificateDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
@@ -103,7 +105,7 @@ func (d *ssoCertificateDataSource) Read(ctx context.Context, req datasource.Read
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading sso certificate",
-			fmt.Sprintf("Unable to get sso certificate, got error: %s", err),
+			fmt.Sprintf("Unable to get sso certificate, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
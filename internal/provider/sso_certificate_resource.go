@@ -24,7 +24,8 @@ func newSSOCertificateResource() resource.Resource {
 }
 
 type ssoCertificateResource struct {
-	client *sendgrid.Client
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
 }
 
 type ssoCertificateResourceModel struct {
@@ -74,18 +75,19 @@ func (r *ssoCertificateResource) Configure(ctx context.Context, req resource.Con
 		return
 	}
 
-	client, ok := req.ProviderData.(*sendgrid.Client)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+	r.rateLimits = data.rateLimits
 }
 
 func (r *ssoCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -95,19 +97,21 @@ func (r *ssoCertificateResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "create", plan.ID)
+
 	input := &sendgrid.InputCreateSSOCertificate{
 		PublicCertificate: plan.PublicCertificate.ValueString(),
 		IntegrationID:     plan.IntegrationID.ValueString(),
 		Enabled:           true,
 	}
 
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
+	res, err := r.rateLimits.retryNonIdempotent(ctx, rateLimitGroupSSO, func() (interface{}, error) {
 		return r.client.CreateSSOCertificate(ctx, input)
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Creating sso certificate",
-			fmt.Sprintf("Unable to create sso certificate, got error: %s", err),
+			fmt.Sprintf("Unable to create sso certificate, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -141,6 +145,8 @@ func (r *ssoCertificateResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "read", state.ID)
+
 	certificateId := state.ID.ValueString()
 	id, _ := strconv.ParseInt(certificateId, 10, 64)
 
@@ -148,7 +154,7 @@ func (r *ssoCertificateResource) Read(ctx context.Context, req resource.ReadRequ
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading sso certificate",
-			fmt.Sprintf("Unable to read sso certificate (id: %v), got error: %s", id, err),
+			fmt.Sprintf("Unable to read sso certificate (id: %v), got error: %s", id, sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -174,6 +180,8 @@ func (r *ssoCertificateResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "update", data.ID)
+
 	input := &sendgrid.InputUpdateSSOCertificate{}
 	if !data.IntegrationID.IsNull() && data.IntegrationID != state.IntegrationID {
 		input.IntegrationID = data.IntegrationID.ValueString()
@@ -189,7 +197,7 @@ func (r *ssoCertificateResource) Update(ctx context.Context, req resource.Update
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Updating sso certificate",
-			fmt.Sprintf("Unable to sso certificate (id: %s), got error: %s", certificateId, err),
+			fmt.Sprintf("Unable to sso certificate (id: %s), got error: %s", certificateId, sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -215,21 +223,25 @@ func (r *ssoCertificateResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "delete", state.ID)
+
 	certificateId := state.ID.ValueString()
 	id, _ := strconv.ParseInt(certificateId, 10, 64)
-	_, err := retryOnRateLimit(ctx, func() (interface{}, error) {
+	_, err := r.rateLimits.retry(ctx, rateLimitGroupSSO, func() (interface{}, error) {
 		return nil, r.client.DeleteSSOCertificate(ctx, id)
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Deleting sso certificate",
-			fmt.Sprintf("Unable to delete sso certificate (id: %v), got error: %s", id, err),
+			fmt.Sprintf("Unable to delete sso certificate (id: %v), got error: %s", id, sendgridErrorDetail(err)),
 		)
 		return
 	}
 }
 
 func (r *ssoCertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = withAPILogFields(ctx, "import", types.StringValue(req.ID))
+
 	var data ssoCertificateResourceModel
 
 	certificateId := req.ID
@@ -240,7 +252,7 @@ func (r *ssoCertificateResource) ImportState(ctx context.Context, req resource.I
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Importing sso certificate",
-			fmt.Sprintf("Unable to read sso certificate, got error: %s", err),
+			fmt.Sprintf("Unable to read sso certificate, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -24,7 +24,8 @@ func newSSOIntegrationDataSource() datasource.DataSource {
 }
 
 type ssoIntegrationDataSource struct {
-	client *sendgrid.Client
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
 }
 
 type ssoIntegrationDataSourceModel struct {
@@ -49,16 +50,17 @@ func (d *ssoIntegrationDataSource) Configure(ctx context.Context, req datasource
 		return
 	}
 
-	client, ok := req.ProviderData.(*sendgrid.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = data.client
+	d.rateLimits = data.rateLimits
 }
 
 func (d *ssoIntegrationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
@@ -120,7 +122,7 @@ func (d *ssoIntegrationDataSource) Read(ctx context.Context, req datasource.Read
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading sso integration",
-			fmt.Sprintf("Unable to get sso integration, got error: %s", err),
+			fmt.Sprintf("Unable to get sso integration, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
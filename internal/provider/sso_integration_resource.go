@@ -23,7 +23,8 @@ func newSSOIntegrationResource() resource.Resource {
 }
 
 type ssoIntegrationResource struct {
-	client *sendgrid.Client
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
 }
 
 type ssoIntegrationResourceModel struct {
@@ -93,18 +94,19 @@ func (r *ssoIntegrationResource) Configure(ctx context.Context, req resource.Con
 		return
 	}
 
-	client, ok := req.ProviderData.(*sendgrid.Client)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+	r.rateLimits = data.rateLimits
 }
 
 func (r *ssoIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -114,6 +116,8 @@ func (r *ssoIntegrationResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "create", plan.ID)
+
 	input := &sendgrid.InputCreateSSOIntegration{
 		Name:       plan.Name.ValueString(),
 		Enabled:    plan.Enabled.ValueBool(),
@@ -126,13 +130,13 @@ func (r *ssoIntegrationResource) Create(ctx context.Context, req resource.Create
 		input.CompletedIntegration = plan.CompletedIntegration.ValueBool()
 	}
 
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
+	res, err := r.rateLimits.retryNonIdempotent(ctx, rateLimitGroupSSO, func() (interface{}, error) {
 		return r.client.CreateSSOIntegration(ctx, input)
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Creating SSO Integration",
-			fmt.Sprintf("Unable to create sso integration, got error: %s", err),
+			fmt.Sprintf("Unable to create sso integration, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -170,13 +174,15 @@ func (r *ssoIntegrationResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "read", state.ID)
+
 	id := state.ID.ValueString()
 
 	o, err := r.client.GetSSOIntegration(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading sso integration",
-			fmt.Sprintf("Unable to read sso integration (id: %v), got error: %s", id, err),
+			fmt.Sprintf("Unable to read sso integration (id: %v), got error: %s", id, sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -206,6 +212,8 @@ func (r *ssoIntegrationResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "update", data.ID)
+
 	input := &sendgrid.InputUpdateSSOIntegration{}
 	if !data.Name.IsNull() && data.Name != state.Name {
 		input.Name = data.Name.ValueString()
@@ -231,7 +239,7 @@ func (r *ssoIntegrationResource) Update(ctx context.Context, req resource.Update
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Updating sso integration",
-			fmt.Sprintf("Unable to sso integration (id: %s), got error: %s", id, err),
+			fmt.Sprintf("Unable to sso integration (id: %s), got error: %s", id, sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -261,21 +269,25 @@ func (r *ssoIntegrationResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "delete", state.ID)
+
 	id := state.ID.ValueString()
 
-	_, err := retryOnRateLimit(ctx, func() (interface{}, error) {
+	_, err := r.rateLimits.retry(ctx, rateLimitGroupSSO, func() (interface{}, error) {
 		return nil, r.client.DeleteSSOIntegration(ctx, id)
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Deleting sso integration",
-			fmt.Sprintf("Unable to delete sso integration (id: %v), got error: %s", id, err),
+			fmt.Sprintf("Unable to delete sso integration (id: %v), got error: %s", id, sendgridErrorDetail(err)),
 		)
 		return
 	}
 }
 
 func (r *ssoIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = withAPILogFields(ctx, "import", types.StringValue(req.ID))
+
 	var data ssoIntegrationResourceModel
 
 	id := req.ID
@@ -285,7 +297,7 @@ func (r *ssoIntegrationResource) ImportState(ctx context.Context, req resource.I
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Importing sso integration",
-			fmt.Sprintf("Unable to read sso integration, got error: %s", err),
+			fmt.Sprintf("Unable to read sso integration, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
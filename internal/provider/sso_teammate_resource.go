@@ -34,7 +34,8 @@ func newSSOTeammateResource() resource.Resource {
 }
 
 type ssoTeammateResource struct {
-	client *sendgrid.Client
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
 }
 
 type ssoSubuserAccessResourceModel struct {
@@ -232,18 +233,19 @@ func (r *ssoTeammateResource) Configure(ctx context.Context, req resource.Config
 		return
 	}
 
-	client, ok := req.ProviderData.(*sendgrid.Client)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+	r.rateLimits = data.rateLimits
 }
 
 func (r *ssoTeammateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -253,6 +255,8 @@ func (r *ssoTeammateResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "create", data.ID)
+
 	// adminitors have all scopes, so we don't need to set them.
 	if data.IsAdmin.ValueBool() && len(data.Scopes) > 0 {
 		resp.Diagnostics.AddError(
@@ -291,13 +295,13 @@ func (r *ssoTeammateResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	// NOTE: Re-execute after the re-executable time has elapsed when a rate limit occurs
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
+	res, err := r.rateLimits.retryNonIdempotent(ctx, rateLimitGroupSSO, func() (interface{}, error) {
 		return r.client.CreateSSOTeammate(context.TODO(), input)
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Creating SSO teammate",
-			fmt.Sprintf("Unable to invite SSO teammate, got error: %s", err),
+			fmt.Sprintf("Unable to invite SSO teammate, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -343,13 +347,15 @@ func (r *ssoTeammateResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "read", data.ID)
+
 	email := data.Email.ValueString()
 
 	o, err := r.client.GetTeammate(ctx, email)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading SSO teammate",
-			fmt.Sprintf("Unable to read SSO teammate, got error: %s", err),
+			fmt.Sprintf("Unable to read SSO teammate, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -365,7 +371,7 @@ func (r *ssoTeammateResource) Read(ctx context.Context, req resource.ReadRequest
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading SSO teammate subuser access",
-			fmt.Sprintf("Unable to read SSO teammate subuser access, got error: %s", err),
+			fmt.Sprintf("Unable to read SSO teammate subuser access, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -419,6 +425,8 @@ func (r *ssoTeammateResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "update", data.ID)
+
 	// adminitors have all scopes, so we don't need to set them.
 	if data.IsAdmin.ValueBool() && len(data.Scopes) > 0 {
 		resp.Diagnostics.AddError(
@@ -457,7 +465,7 @@ func (r *ssoTeammateResource) Update(ctx context.Context, req resource.UpdateReq
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Updating SSO teammate",
-			fmt.Sprintf("Unable to update SSO teammate, got error: %s", err),
+			fmt.Sprintf("Unable to update SSO teammate, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -507,9 +515,11 @@ func (r *ssoTeammateResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "delete", data.ID)
+
 	email := data.Email.ValueString()
 
-	_, err := retryOnRateLimit(ctx, func() (interface{}, error) {
+	_, err := r.rateLimits.retry(ctx, rateLimitGroupSSO, func() (interface{}, error) {
 		return nil, r.client.DeleteTeammate(ctx, email)
 	})
 	if err != nil {
@@ -518,7 +528,7 @@ func (r *ssoTeammateResource) Delete(ctx context.Context, req resource.DeleteReq
 			fmt.Sprintf(
 				"Could not delete SSO teammate %s, unexpected error: %s",
 				email,
-				err,
+				sendgridErrorDetail(err),
 			),
 		)
 		return
@@ -526,6 +536,8 @@ func (r *ssoTeammateResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *ssoTeammateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = withAPILogFields(ctx, "import", types.StringValue(req.ID))
+
 	var data ssoTeammateResourceModel
 
 	email := req.ID
@@ -536,7 +548,7 @@ func (r *ssoTeammateResource) ImportState(ctx context.Context, req resource.Impo
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Importing SSO teammate",
-			fmt.Sprintf("Unable to read SSO teammate, got error: %s", err),
+			fmt.Sprintf("Unable to read SSO teammate, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -552,7 +564,7 @@ func (r *ssoTeammateResource) ImportState(ctx context.Context, req resource.Impo
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading SSO teammate subuser access",
-			fmt.Sprintf("Unable to read SSO teammate subuser access, got error: %s", err),
+			fmt.Sprintf("Unable to read SSO teammate subuser access, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -0,0 +1,376 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+	// NOTE: Embed the time zone database so that timezone works on hosts without one, such as Windows.
+	_ "time/tzdata"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/i10416/sendgrid"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &statsDataSource{}
+	_ datasource.DataSourceWithConfigure = &statsDataSource{}
+)
+
+// statsDateLayout is the date format of the SendGrid stats API.
+const statsDateLayout = "2006-01-02"
+
+// globalStatsGetter retrieves the global email statistics.
+type globalStatsGetter interface {
+	GetGlobalStats(ctx context.Context, opts *sendgrid.StatsOptions) ([]sendgrid.GlobalStat, error)
+}
+
+// globalStatsCache is shared by every stats data source, so that repeated references to the same query
+// within a single Terraform command are answered from memory.
+var globalStatsCache = newStatsCache(time.Now)
+
+func newStatsDataSource() datasource.DataSource {
+	return &statsDataSource{
+		cache: globalStatsCache,
+	}
+}
+
+type statsDataSource struct {
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
+	cache      *statsCache
+}
+
+type statsDataSourceModel struct {
+	ID           types.String               `tfsdk:"id"`
+	StartDate    types.String               `tfsdk:"start_date"`
+	EndDate      types.String               `tfsdk:"end_date"`
+	AggregatedBy types.String               `tfsdk:"aggregated_by"`
+	Timezone     types.String               `tfsdk:"timezone"`
+	CacheTTL     types.String               `tfsdk:"cache_ttl"`
+	Stats        []statsDataSourceItemModel `tfsdk:"stats"`
+}
+
+type statsDataSourceItemModel struct {
+	Date             types.String `tfsdk:"date"`
+	Blocks           types.Int64  `tfsdk:"blocks"`
+	BounceDrops      types.Int64  `tfsdk:"bounce_drops"`
+	Bounces          types.Int64  `tfsdk:"bounces"`
+	Clicks           types.Int64  `tfsdk:"clicks"`
+	DeferredDrops    types.Int64  `tfsdk:"deferred_drops"`
+	Delivered        types.Int64  `tfsdk:"delivered"`
+	InvalidEmails    types.Int64  `tfsdk:"invalid_emails"`
+	Opens            types.Int64  `tfsdk:"opens"`
+	Processed        types.Int64  `tfsdk:"processed"`
+	Requests         types.Int64  `tfsdk:"requests"`
+	SpamReportDrops  types.Int64  `tfsdk:"spam_report_drops"`
+	SpamReports      types.Int64  `tfsdk:"spam_reports"`
+	UniqueClicks     types.Int64  `tfsdk:"unique_clicks"`
+	UniqueOpens      types.Int64  `tfsdk:"unique_opens"`
+	UnsubscribeDrops types.Int64  `tfsdk:"unsubscribe_drops"`
+	Unsubscribes     types.Int64  `tfsdk:"unsubscribes"`
+}
+
+func (d *statsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stats"
+}
+
+func (d *statsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = data.client
+	d.rateLimits = data.rateLimits
+}
+
+func (d *statsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	metric := func(description string) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			MarkdownDescription: description,
+			Computed:            true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Provides the global email statistics of the account.
+
+SendGrid aggregates statistics by UTC day. When ` + "`timezone`" + ` is set, ` + "`start_date`" + ` and ` + "`end_date`" + ` are interpreted as dates in that time zone
+and converted to the UTC days that cover them before calling the API, so the first and last days may include activity from outside the local range.
+
+For more detailed information, please see the [SendGrid documentation](https://www.twilio.com/docs/sendgrid/api-reference/stats/retrieve-global-email-statistics).
+		`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"start_date": schema.StringAttribute{
+				MarkdownDescription: "The first day of the statistics, formatted as YYYY-MM-DD. Example: 2024-01-01",
+				Required:            true,
+			},
+			"end_date": schema.StringAttribute{
+				MarkdownDescription: "The last day of the statistics, formatted as YYYY-MM-DD. Defaults to today.",
+				Optional:            true,
+			},
+			"aggregated_by": schema.StringAttribute{
+				MarkdownDescription: "How to group the statistics. Can be `day`, `week` or `month`. Defaults to `day`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringOneOf("day", "week", "month"),
+				},
+			},
+			"timezone": schema.StringAttribute{
+				MarkdownDescription: "The IANA time zone `start_date` and `end_date` are interpreted in. Example: Asia/Tokyo. Defaults to `UTC`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringTimezone(),
+				},
+			},
+			"cache_ttl": schema.StringAttribute{
+				MarkdownDescription: "How long the result is reused by other reads of the same query within a single Terraform command, e.g. `5m`. Not cached by default.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringPositiveDuration(),
+				},
+			},
+			"stats": schema.ListNestedAttribute{
+				MarkdownDescription: "The statistics of each period, in date order.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"date": schema.StringAttribute{
+							MarkdownDescription: "The first UTC day of the period, formatted as YYYY-MM-DD",
+							Computed:            true,
+						},
+						"blocks":            metric("The number of emails blocked by the receiving server"),
+						"bounce_drops":      metric("The number of emails dropped because the address previously bounced"),
+						"bounces":           metric("The number of emails that bounced"),
+						"clicks":            metric("The number of link clicks"),
+						"deferred_drops":    metric("The number of emails dropped after being deferred"),
+						"delivered":         metric("The number of emails delivered"),
+						"invalid_emails":    metric("The number of emails sent to invalid addresses"),
+						"opens":             metric("The number of opens"),
+						"processed":         metric("The number of emails processed"),
+						"requests":          metric("The number of emails requested to be sent"),
+						"spam_report_drops": metric("The number of emails dropped because the recipient reported spam"),
+						"spam_reports":      metric("The number of spam reports"),
+						"unique_clicks":     metric("The number of recipients who clicked a link"),
+						"unique_opens":      metric("The number of recipients who opened an email"),
+						"unsubscribe_drops": metric("The number of emails dropped because the recipient unsubscribed"),
+						"unsubscribes":      metric("The number of unsubscribes"),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *statsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var s statsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &s)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timezone := "UTC"
+	if !s.Timezone.IsNull() {
+		timezone = s.Timezone.ValueString()
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("timezone"),
+			"Reading stats",
+			fmt.Sprintf("Unable to load time zone, got error: %s", err),
+		)
+		return
+	}
+
+	startDate, endDate, err := statsDateRangeUTC(s.StartDate.ValueString(), s.EndDate.ValueString(), loc)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading stats",
+			fmt.Sprintf("Unable to convert the date range to UTC, got error: %s", err),
+		)
+		return
+	}
+
+	var ttl time.Duration
+	if !s.CacheTTL.IsNull() {
+		// NOTE: cache_ttl is checked by its validator, so an error here means it is not cached.
+		ttl, _ = time.ParseDuration(s.CacheTTL.ValueString())
+	}
+
+	stats, err := d.cache.globalStats(ctx, d.rateLimits, d.client, &sendgrid.StatsOptions{
+		StartDate:   startDate,
+		EndDate:     endDate,
+		Aggregation: s.AggregatedBy.ValueString(),
+	}, ttl)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading stats",
+			fmt.Sprintf("Unable to get stats, got error: %s", sendgridErrorDetail(err)),
+		)
+		return
+	}
+
+	s.ID = types.StringValue("stats")
+	s.Stats = stats
+	resp.Diagnostics.Append(resp.State.Set(ctx, &s)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// statsDateRangeUTC converts start and end dates in loc into the UTC days that cover them.
+// The start date is converted at the start of its day and the end date at the end of its day,
+// so the UTC range never misses activity from the local range. An empty end date stays empty.
+func statsDateRangeUTC(start, end string, loc *time.Location) (string, string, error) {
+	s, err := time.ParseInLocation(statsDateLayout, start, loc)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid start_date %q: %w", start, err)
+	}
+	startUTC := s.UTC().Format(statsDateLayout)
+
+	if end == "" {
+		return startUTC, "", nil
+	}
+
+	e, err := time.ParseInLocation(statsDateLayout, end, loc)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid end_date %q: %w", end, err)
+	}
+	if e.Before(s) {
+		return "", "", fmt.Errorf("end_date %q is before start_date %q", end, start)
+	}
+	endUTC := e.AddDate(0, 0, 1).Add(-time.Nanosecond).UTC().Format(statsDateLayout)
+
+	return startUTC, endUTC, nil
+}
+
+// statsCache keeps the results of stats queries in memory until their TTL expires.
+type statsCache struct {
+	mu      sync.Mutex
+	now     func() time.Time
+	entries map[statsCacheKey]statsCacheEntry
+}
+
+// statsCacheKey identifies a query. The client is part of the key, so provider configurations
+// with different API keys or subusers never share results.
+type statsCacheKey struct {
+	client       globalStatsGetter
+	startDate    string
+	endDate      string
+	aggregatedBy string
+}
+
+type statsCacheEntry struct {
+	stats   []statsDataSourceItemModel
+	expires time.Time
+}
+
+func newStatsCache(now func() time.Time) *statsCache {
+	return &statsCache{
+		now:     now,
+		entries: map[statsCacheKey]statsCacheEntry{},
+	}
+}
+
+// globalStats returns the global statistics matching opts, reusing the result of the same query
+// for ttl. A ttl of zero disables caching.
+func (c *statsCache) globalStats(ctx context.Context, rateLimits *rateLimitBuckets, client globalStatsGetter, opts *sendgrid.StatsOptions, ttl time.Duration) ([]statsDataSourceItemModel, error) {
+	if ttl <= 0 {
+		return listGlobalStats(ctx, rateLimits, client, opts)
+	}
+
+	key := statsCacheKey{
+		client:       client,
+		startDate:    opts.StartDate,
+		endDate:      opts.EndDate,
+		aggregatedBy: opts.Aggregation,
+	}
+
+	c.mu.Lock()
+	e, ok := c.entries[key]
+	c.mu.Unlock()
+	if ok && c.now().Before(e.expires) {
+		return e.stats, nil
+	}
+
+	stats, err := listGlobalStats(ctx, rateLimits, client, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.entries[key] = statsCacheEntry{stats: stats, expires: c.now().Add(ttl)}
+	c.mu.Unlock()
+
+	return stats, nil
+}
+
+// listGlobalStats returns the global statistics matching opts.
+func listGlobalStats(ctx context.Context, rateLimits *rateLimitBuckets, client globalStatsGetter, opts *sendgrid.StatsOptions) ([]statsDataSourceItemModel, error) {
+	// NOTE: Re-execute after the re-executable time has elapsed when a rate limit occurs
+	res, err := rateLimits.retry(ctx, rateLimitGroupStats, func() (interface{}, error) {
+		return client.GetGlobalStats(ctx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stats, ok := res.([]sendgrid.GlobalStat)
+	if !ok {
+		return nil, fmt.Errorf("failed to assert type []sendgrid.GlobalStat")
+	}
+
+	items := []statsDataSourceItemModel{}
+	for _, s := range stats {
+		items = append(items, statsItemModel(s))
+	}
+	return items, nil
+}
+
+func statsItemModel(s sendgrid.GlobalStat) statsDataSourceItemModel {
+	m := s.Stats
+	return statsDataSourceItemModel{
+		Date:             types.StringValue(s.Date),
+		Blocks:           types.Int64Value(int64(m.Blocks)),
+		BounceDrops:      types.Int64Value(int64(m.BounceDrops)),
+		Bounces:          types.Int64Value(int64(m.Bounces)),
+		Clicks:           types.Int64Value(int64(m.Clicks)),
+		DeferredDrops:    types.Int64Value(int64(m.DeferredDrops)),
+		Delivered:        types.Int64Value(int64(m.Delivered)),
+		InvalidEmails:    types.Int64Value(int64(m.InvalidEmails)),
+		Opens:            types.Int64Value(int64(m.Opens)),
+		Processed:        types.Int64Value(int64(m.Processed)),
+		Requests:         types.Int64Value(int64(m.Requests)),
+		SpamReportDrops:  types.Int64Value(int64(m.SpamReportDrops)),
+		SpamReports:      types.Int64Value(int64(m.SpamReports)),
+		UniqueClicks:     types.Int64Value(int64(m.UniqueClicks)),
+		UniqueOpens:      types.Int64Value(int64(m.UniqueOpens)),
+		UnsubscribeDrops: types.Int64Value(int64(m.UnsubscribeDrops)),
+		Unsubscribes:     types.Int64Value(int64(m.Unsubscribes)),
+	}
+}
@@ -0,0 +1,236 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/i10416/sendgrid"
+)
+
+type mockGlobalStatsGetter struct {
+	stats []sendgrid.GlobalStat
+	opts  *sendgrid.StatsOptions
+	calls int
+}
+
+func (m *mockGlobalStatsGetter) GetGlobalStats(_ context.Context, opts *sendgrid.StatsOptions) ([]sendgrid.GlobalStat, error) {
+	m.calls++
+	m.opts = opts
+	return m.stats, nil
+}
+
+func TestAccStatsDataSource(t *testing.T) {
+	resourceName := "data.sendgrid_stats.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `
+data "sendgrid_stats" "test" {
+  start_date = "2024-01-01"
+  end_date   = "2024-01-07"
+  timezone   = "Asia/Tokyo"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "stats"),
+					resource.TestCheckResourceAttrSet(resourceName, "stats.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestStatsDateRangeUTC(t *testing.T) {
+	cases := []struct {
+		name      string
+		timezone  string
+		start     string
+		end       string
+		wantStart string
+		wantEnd   string
+	}{
+		{
+			name:      "utc",
+			timezone:  "UTC",
+			start:     "2024-01-10",
+			end:       "2024-01-12",
+			wantStart: "2024-01-10",
+			wantEnd:   "2024-01-12",
+		},
+		{
+			name:      "ahead of utc starts the day before",
+			timezone:  "Asia/Tokyo",
+			start:     "2024-01-10",
+			end:       "2024-01-12",
+			wantStart: "2024-01-09",
+			wantEnd:   "2024-01-12",
+		},
+		{
+			name:      "behind utc ends the day after",
+			timezone:  "America/Los_Angeles",
+			start:     "2024-01-10",
+			end:       "2024-01-12",
+			wantStart: "2024-01-10",
+			wantEnd:   "2024-01-13",
+		},
+		{
+			name:      "month boundary",
+			timezone:  "Asia/Tokyo",
+			start:     "2024-03-01",
+			end:       "2024-03-01",
+			wantStart: "2024-02-29",
+			wantEnd:   "2024-03-01",
+		},
+		{
+			name:      "no end date",
+			timezone:  "Asia/Tokyo",
+			start:     "2024-01-10",
+			wantStart: "2024-01-09",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			loc, err := time.LoadLocation(c.timezone)
+			if err != nil {
+				t.Fatalf("unable to load %s: %s", c.timezone, err)
+			}
+
+			start, end, err := statsDateRangeUTC(c.start, c.end, loc)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if start != c.wantStart || end != c.wantEnd {
+				t.Errorf("got %s..%s, want %s..%s", start, end, c.wantStart, c.wantEnd)
+			}
+		})
+	}
+}
+
+func TestStatsDateRangeUTCInvalid(t *testing.T) {
+	cases := []struct {
+		name  string
+		start string
+		end   string
+	}{
+		{name: "invalid start", start: "2024/01/10"},
+		{name: "invalid end", start: "2024-01-10", end: "tomorrow"},
+		{name: "end before start", start: "2024-01-10", end: "2024-01-09"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if _, _, err := statsDateRangeUTC(c.start, c.end, time.UTC); err == nil {
+				t.Error("got no error, want one")
+			}
+		})
+	}
+}
+
+func TestListGlobalStats(t *testing.T) {
+	client := &mockGlobalStatsGetter{
+		stats: []sendgrid.GlobalStat{
+			{Date: "2024-01-09", Stats: sendgrid.StatMetrics{Requests: 10, Delivered: 9, Bounces: 1, UniqueOpens: 4}},
+			{Date: "2024-01-10", Stats: sendgrid.StatMetrics{}},
+		},
+	}
+	opts := &sendgrid.StatsOptions{StartDate: "2024-01-09", EndDate: "2024-01-10"}
+
+	got, err := listGlobalStats(context.Background(), nil, client, opts)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if client.opts != opts {
+		t.Errorf("got options %+v, want %+v", client.opts, opts)
+	}
+
+	if len(got) != 2 {
+		t.Fatalf("got %d stats, want 2", len(got))
+	}
+	want := statsItemModel(client.stats[0])
+	if !reflect.DeepEqual(got[0], want) {
+		t.Errorf("got %+v, want %+v", got[0], want)
+	}
+	if got[0].Date != types.StringValue("2024-01-09") || got[0].Delivered != types.Int64Value(9) || got[0].UniqueOpens != types.Int64Value(4) {
+		t.Errorf("got %+v, want the date and metrics of the first day", got[0])
+	}
+	if got[1].Requests != types.Int64Value(0) {
+		t.Errorf("got requests %s, want 0 for a day without activity", got[1].Requests)
+	}
+}
+
+func TestStatsCache(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	cache := newStatsCache(func() time.Time { return now })
+	client := &mockGlobalStatsGetter{
+		stats: []sendgrid.GlobalStat{{Date: "2024-01-09", Stats: sendgrid.StatMetrics{Delivered: 9}}},
+	}
+	query := func() *sendgrid.StatsOptions {
+		return &sendgrid.StatsOptions{StartDate: "2024-01-09", EndDate: "2024-01-10"}
+	}
+
+	first, err := cache.globalStats(ctx, nil, client, query(), time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	second, err := cache.globalStats(ctx, nil, client, query(), time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if client.calls != 1 {
+		t.Errorf("got %d calls, want the same query to be answered from the cache", client.calls)
+	}
+	if !reflect.DeepEqual(first, second) {
+		t.Errorf("got %+v from the cache, want %+v", second, first)
+	}
+
+	if _, err := cache.globalStats(ctx, nil, client, &sendgrid.StatsOptions{StartDate: "2024-01-09", Aggregation: "week"}, time.Minute); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if client.calls != 2 {
+		t.Errorf("got %d calls, want a different query to be fetched", client.calls)
+	}
+
+	other := &mockGlobalStatsGetter{stats: client.stats}
+	if _, err := cache.globalStats(ctx, nil, other, query(), time.Minute); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if other.calls != 1 {
+		t.Errorf("got %d calls, want another client to never share the cache", other.calls)
+	}
+
+	now = now.Add(time.Minute)
+	if _, err := cache.globalStats(ctx, nil, client, query(), time.Minute); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if client.calls != 3 {
+		t.Errorf("got %d calls, want an expired entry to be fetched again", client.calls)
+	}
+}
+
+func TestStatsCacheDisabled(t *testing.T) {
+	ctx := context.Background()
+	cache := newStatsCache(time.Now)
+	client := &mockGlobalStatsGetter{}
+
+	for range 2 {
+		if _, err := cache.globalStats(ctx, nil, client, &sendgrid.StatsOptions{StartDate: "2024-01-09"}, 0); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	if client.calls != 2 {
+		t.Errorf("got %d calls, want every read to be fetched without cache_ttl", client.calls)
+	}
+}
@@ -24,7 +24,8 @@ func newSubuserDataSource() datasource.DataSource {
 }
 
 type subuserDataSource struct {
-	client *sendgrid.Client
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
 }
 
 type subuserDataSourceModel struct {
@@ -43,16 +44,17 @@ func (d *subuserDataSource) Configure(ctx context.Context, req datasource.Config
 		return
 	}
 
-	client, ok := req.ProviderData.(*sendgrid.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = data.client
+	d.rateLimits = data.rateLimits
 }
 
 func (d *subuserDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
@@ -99,7 +101,7 @@ func (d *subuserDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading subuser",
-			fmt.Sprintf("Unable to get subuser by username: %s, err: %s", username, err.Error()),
+			fmt.Sprintf("Unable to get subuser by username: %s, err: %s", username, sendgridErrorDetail(err)),
 		)
 		return
 	}
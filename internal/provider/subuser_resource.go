@@ -26,7 +26,8 @@ func newSubuserResource() resource.Resource {
 }
 
 type subuserResource struct {
-	client *sendgrid.Client
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
 }
 
 type subuserResourceModel struct {
@@ -91,18 +92,19 @@ func (r *subuserResource) Configure(ctx context.Context, req resource.ConfigureR
 		return
 	}
 
-	client, ok := req.ProviderData.(*sendgrid.Client)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+	r.rateLimits = data.rateLimits
 }
 
 func (r *subuserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -112,10 +114,12 @@ func (r *subuserResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "create", plan.ID)
+
 	ips := flex.ExpandFrameworkStringSet(ctx, plan.Ips)
 
 	// NOTE: Re-execute after the re-executable time has elapsed when a rate limit occurs
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
+	res, err := r.rateLimits.retryNonIdempotent(ctx, rateLimitGroupSubusers, func() (interface{}, error) {
 		return r.client.CreateSubuser(ctx, &sendgrid.InputCreateSubuser{
 			Username: plan.Username.ValueString(),
 			Email:    plan.Email.ValueString(),
@@ -126,7 +130,7 @@ func (r *subuserResource) Create(ctx context.Context, req resource.CreateRequest
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Creating subuser",
-			fmt.Sprintf("Unable to create subuser, got error: %s", err),
+			fmt.Sprintf("Unable to create subuser, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -156,6 +160,8 @@ func (r *subuserResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "read", state.ID)
+
 	username := state.Username.ValueString()
 
 	subusers, err := r.client.GetSubusers(ctx, &sendgrid.InputGetSubusers{
@@ -165,7 +171,7 @@ func (r *subuserResource) Read(ctx context.Context, req resource.ReadRequest, re
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading subuser",
-			fmt.Sprintf("Unable to read subuser (username: %s), got error: %s", username, err),
+			fmt.Sprintf("Unable to read subuser (username: %s), got error: %s", username, sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -199,13 +205,15 @@ func (r *subuserResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "update", data.ID)
+
 	username := data.Username.ValueString()
 	ips := flex.ExpandFrameworkStringSet(ctx, data.Ips)
 
 	if err := r.client.UpdateSubuserIps(ctx, username, ips); err != nil {
 		resp.Diagnostics.AddError(
 			"Updating subuser",
-			fmt.Sprintf("Unable to update subuser's ips (username: %s), got error: %s", username, err),
+			fmt.Sprintf("Unable to update subuser's ips (username: %s), got error: %s", username, sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -224,22 +232,26 @@ func (r *subuserResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "delete", state.ID)
+
 	username := state.Username.ValueString()
 
 	// NOTE: Re-execute after the re-executable time has elapsed when a rate limit occurs
-	_, err := retryOnRateLimit(ctx, func() (interface{}, error) {
+	_, err := r.rateLimits.retry(ctx, rateLimitGroupSubusers, func() (interface{}, error) {
 		return nil, r.client.DeleteSubuser(ctx, username)
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Deleting subuser",
-			fmt.Sprintf("Unable to delete subuser (username: %s), got error: %s", username, err),
+			fmt.Sprintf("Unable to delete subuser (username: %s), got error: %s", username, sendgridErrorDetail(err)),
 		)
 		return
 	}
 }
 
 func (r *subuserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = withAPILogFields(ctx, "import", types.StringValue(req.ID))
+
 	var data subuserResourceModel
 
 	username := req.ID
@@ -253,7 +265,7 @@ func (r *subuserResource) ImportState(ctx context.Context, req resource.ImportSt
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Importing subuser",
-			fmt.Sprintf("Unable to read subuser, got error: %s", err),
+			fmt.Sprintf("Unable to read subuser, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
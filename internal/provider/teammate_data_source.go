@@ -24,7 +24,8 @@ func newTeammateDataSource() datasource.DataSource {
 }
 
 type teammateDataSource struct {
-	client *sendgrid.Client
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
 }
 
 type teammateDataSourceModel struct {
@@ -56,16 +57,17 @@ func (d *teammateDataSource) Configure(ctx context.Context, req datasource.Confi
 		return
 	}
 
-	client, ok := req.ProviderData.(*sendgrid.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = data.client
+	d.rateLimits = data.rateLimits
 }
 
 func (d *teammateDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
@@ -159,7 +161,7 @@ func (d *teammateDataSource) Read(ctx context.Context, req datasource.ReadReques
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading teammate",
-			fmt.Sprintf("Unable to Read teammate: %s, err: %s", email, err.Error()),
+			fmt.Sprintf("Unable to Read teammate: %s, err: %s", email, sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -185,7 +187,7 @@ func (d *teammateDataSource) Read(ctx context.Context, req datasource.ReadReques
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading teammate",
-			fmt.Sprintf("Unable to get teammate by email: %s, err: %s", email, err.Error()),
+			fmt.Sprintf("Unable to get teammate by email: %s, err: %s", email, sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -202,7 +204,7 @@ func (d *teammateDataSource) Read(ctx context.Context, req datasource.ReadReques
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading teammate",
-			fmt.Sprintf("Unable to get teammate (%s), err: %s", email, err.Error()),
+			fmt.Sprintf("Unable to get teammate (%s), err: %s", email, sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/i10416/sendgrid"
+)
+
+// TeammateLister lists the teammates on the account one page at a time.
+type TeammateLister interface {
+	GetTeammates(ctx context.Context, input *sendgrid.InputGetTeammates) (*sendgrid.OutputGetTeammates, error)
+}
+
+// TeammateImport is the resolution of a teammate email to the id used by `terraform import sendgrid_teammate`.
+// Found is false if no teammate has the email, in which case Username and ImportID are empty.
+type TeammateImport struct {
+	Email    string
+	Username string
+	Found    bool
+	ImportID string
+}
+
+// BuildTeammateImports resolves teammate emails, e.g. read from a CSV file, to their usernames and import ids.
+// Surrounding whitespace in emails is ignored. The imports are returned in the same order as emails.
+func BuildTeammateImports(ctx context.Context, client TeammateLister, emails []string) ([]TeammateImport, error) {
+	teammates, err := paginateAll(ctx, 50, func(ctx context.Context, limit, offset int) ([]sendgrid.Teammate, error) {
+		r, err := client.GetTeammates(ctx, &sendgrid.InputGetTeammates{
+			Limit:  limit,
+			Offset: offset,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return r.Teammates, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	usernames := map[string]string{}
+	for _, t := range teammates {
+		usernames[t.Email] = t.Username
+	}
+
+	imports := make([]TeammateImport, 0, len(emails))
+	for _, email := range emails {
+		email = strings.TrimSpace(email)
+		username, ok := usernames[email]
+		if !ok {
+			imports = append(imports, TeammateImport{Email: email})
+			continue
+		}
+		imports = append(imports, TeammateImport{
+			Email:    email,
+			Username: username,
+			Found:    true,
+			ImportID: username,
+		})
+	}
+
+	return imports, nil
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/i10416/sendgrid"
+)
+
+type mockTeammateLister struct {
+	teammates []sendgrid.Teammate
+	err       error
+}
+
+func (m *mockTeammateLister) GetTeammates(_ context.Context, input *sendgrid.InputGetTeammates) (*sendgrid.OutputGetTeammates, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	start := min(input.Offset, len(m.teammates))
+	end := min(input.Offset+input.Limit, len(m.teammates))
+	return &sendgrid.OutputGetTeammates{Teammates: m.teammates[start:end]}, nil
+}
+
+func TestBuildTeammateImports(t *testing.T) {
+	client := &mockTeammateLister{}
+	// More teammates than a single page.
+	for i := 0; i < 60; i++ {
+		client.teammates = append(client.teammates, sendgrid.Teammate{
+			Email:    "other" + string(rune('a'+i%26)) + "@example.com",
+			Username: "other",
+		})
+	}
+	client.teammates = append(client.teammates,
+		sendgrid.Teammate{Email: "alice@example.com", Username: "alice"},
+		sendgrid.Teammate{Email: "bob@example.com", Username: "bob@example.com"},
+	)
+
+	got, err := BuildTeammateImports(context.Background(), client, []string{" bob@example.com", "carol@example.com", "alice@example.com "})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := []TeammateImport{
+		{Email: "bob@example.com", Username: "bob@example.com", Found: true, ImportID: "bob@example.com"},
+		{Email: "carol@example.com"},
+		{Email: "alice@example.com", Username: "alice", Found: true, ImportID: "alice"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestBuildTeammateImportsListError(t *testing.T) {
+	want := errors.New("boom")
+	_, err := BuildTeammateImports(context.Background(), &mockTeammateLister{err: want}, []string{"alice@example.com"})
+	if !errors.Is(err, want) {
+		t.Errorf("got error %v, want %v", err, want)
+	}
+}
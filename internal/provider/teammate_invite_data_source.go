@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/i10416/sendgrid"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &teammateInviteDataSource{}
+	_ datasource.DataSourceWithConfigure = &teammateInviteDataSource{}
+)
+
+func newTeammateInviteDataSource() datasource.DataSource {
+	return &teammateInviteDataSource{}
+}
+
+type teammateInviteDataSource struct {
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
+}
+
+type teammateInviteDataSourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Email          types.String `tfsdk:"email"`
+	Pending        types.Bool   `tfsdk:"pending"`
+	Token          types.String `tfsdk:"token"`
+	ExpirationDate types.Int64  `tfsdk:"expiration_date"`
+}
+
+func (d *teammateInviteDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_teammate_invite"
+}
+
+func (d *teammateInviteDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = data.client
+	d.rateLimits = data.rateLimits
+}
+
+func (d *teammateInviteDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Provides the pending invite of a teammate, including the invite token that automation can use to build the accept URL.
+
+When the teammate has no pending invite, for example because it was already accepted, ` + "`pending`" + ` is false and ` + "`token`" + ` is null.
+
+For more detailed information, please see the [SendGrid documentation](https://www.twilio.com/docs/sendgrid/api-reference/teammates/retrieve-all-pending-teammates).
+		`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email of the invited teammate",
+				Required:            true,
+			},
+			"pending": schema.BoolAttribute{
+				MarkdownDescription: "Set to true if the teammate has a pending invite",
+				Computed:            true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "Token of the pending invite. Null when there is no pending invite or SendGrid does not return a token for it.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"expiration_date": schema.Int64Attribute{
+				MarkdownDescription: "Unix timestamp at which the pending invite expires. Null when there is no pending invite.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *teammateInviteDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var s teammateInviteDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &s)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	email := s.Email.ValueString()
+
+	res, err := d.rateLimits.retry(ctx, rateLimitGroupTeammates, func() (interface{}, error) {
+		return pendingTeammateByEmail(ctx, d.client, email)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading teammate invite",
+			fmt.Sprintf("Unable to get pending teammates, got error: %s", sendgridErrorDetail(err)),
+		)
+		return
+	}
+
+	pendingUser, ok := res.(*sendgrid.PendingTeammate)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Reading teammate invite",
+			"Failed to assert type *sendgrid.PendingTeammate",
+		)
+		return
+	}
+
+	s = teammateInviteModel(email, pendingUser)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &s)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// teammateInviteModel maps the pending invite for email, which is nil when there is none, to the data source model.
+func teammateInviteModel(email string, invite *sendgrid.PendingTeammate) teammateInviteDataSourceModel {
+	m := teammateInviteDataSourceModel{
+		ID:             types.StringValue(email),
+		Email:          types.StringValue(email),
+		Pending:        types.BoolValue(false),
+		Token:          types.StringNull(),
+		ExpirationDate: types.Int64Null(),
+	}
+	if invite == nil {
+		return m
+	}
+
+	m.Pending = types.BoolValue(true)
+	m.ExpirationDate = types.Int64Value(int64(invite.ExpirationDate))
+	if invite.Token != "" {
+		m.Token = types.StringValue(invite.Token)
+	}
+	return m
+}
@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/i10416/sendgrid"
+)
+
+func TestTeammateInviteDataSourceWithMockTransport(t *testing.T) {
+	rt := newMockTransport()
+	rt.Handle(http.MethodGet, "/v3/teammates/pending", func(_ *http.Request) (int, any) {
+		return http.StatusOK, map[string]any{
+			"result": []map[string]any{
+				{"email": "invited@example.com", "scopes": []string{"user.profile.read"}, "token": "abc123", "expiration_date": 1700000000},
+				{"email": "tokenless@example.com", "scopes": []string{"user.profile.read"}, "expiration_date": 1700000000},
+			},
+		}
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactoriesWithTransport(rt),
+		Steps: []resource.TestStep{
+			{
+				Config: testTeammateInviteDataSourceMockConfig("Invited@example.com", "tokenless@example.com", "accepted@example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sendgrid_teammate_invite.invited", "pending", "true"),
+					resource.TestCheckResourceAttr("data.sendgrid_teammate_invite.invited", "token", "abc123"),
+					resource.TestCheckResourceAttr("data.sendgrid_teammate_invite.invited", "expiration_date", "1700000000"),
+					resource.TestCheckResourceAttr("data.sendgrid_teammate_invite.tokenless", "pending", "true"),
+					resource.TestCheckNoResourceAttr("data.sendgrid_teammate_invite.tokenless", "token"),
+					resource.TestCheckResourceAttr("data.sendgrid_teammate_invite.accepted", "pending", "false"),
+					resource.TestCheckNoResourceAttr("data.sendgrid_teammate_invite.accepted", "token"),
+					resource.TestCheckNoResourceAttr("data.sendgrid_teammate_invite.accepted", "expiration_date"),
+				),
+			},
+		},
+	})
+}
+
+func TestTeammateInviteModel(t *testing.T) {
+	cases := []struct {
+		name   string
+		invite *sendgrid.PendingTeammate
+		want   teammateInviteDataSourceModel
+	}{
+		{
+			name: "no pending invite",
+			want: teammateInviteDataSourceModel{
+				ID:             types.StringValue("user@example.com"),
+				Email:          types.StringValue("user@example.com"),
+				Pending:        types.BoolValue(false),
+				Token:          types.StringNull(),
+				ExpirationDate: types.Int64Null(),
+			},
+		},
+		{
+			name:   "pending invite",
+			invite: &sendgrid.PendingTeammate{Email: "user@example.com", Token: "abc123", ExpirationDate: 1700000000},
+			want: teammateInviteDataSourceModel{
+				ID:             types.StringValue("user@example.com"),
+				Email:          types.StringValue("user@example.com"),
+				Pending:        types.BoolValue(true),
+				Token:          types.StringValue("abc123"),
+				ExpirationDate: types.Int64Value(1700000000),
+			},
+		},
+		{
+			name:   "pending invite without token",
+			invite: &sendgrid.PendingTeammate{Email: "user@example.com", ExpirationDate: 1700000000},
+			want: teammateInviteDataSourceModel{
+				ID:             types.StringValue("user@example.com"),
+				Email:          types.StringValue("user@example.com"),
+				Pending:        types.BoolValue(true),
+				Token:          types.StringNull(),
+				ExpirationDate: types.Int64Value(1700000000),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := teammateInviteModel("user@example.com", c.invite); got != c.want {
+				t.Errorf("got %+v, want %+v", got, c.want)
+			}
+		})
+	}
+}
+
+func testTeammateInviteDataSourceMockConfig(invited, tokenless, accepted string) string {
+	return fmt.Sprintf(`
+provider "sendgrid" {
+	api_key = "SG.test"
+}
+
+data "sendgrid_teammate_invite" "invited" {
+	email = "%[1]s"
+}
+
+data "sendgrid_teammate_invite" "tokenless" {
+	email = "%[2]s"
+}
+
+data "sendgrid_teammate_invite" "accepted" {
+	email = "%[3]s"
+}
+`, invited, tokenless, accepted)
+}
@@ -5,6 +5,8 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/i10416/sendgrid"
 )
@@ -18,7 +20,7 @@ func pendingTeammateByEmail(ctx context.Context, client *sendgrid.Client, email
 	var pendingTeammate *sendgrid.PendingTeammate
 	for _, t := range r.PendingTeammates {
 		t := &t
-		if email != t.Email {
+		if !strings.EqualFold(email, t.Email) {
 			continue
 		}
 		pendingTeammate = t
@@ -44,7 +46,7 @@ func getTeammateByEmail(ctx context.Context, client *sendgrid.Client, email stri
 
 		for _, t := range r.Teammates {
 			t := &t
-			if email == t.Email {
+			if strings.EqualFold(email, t.Email) {
 				return t, nil
 			}
 		}
@@ -58,3 +60,30 @@ func getTeammateByEmail(ctx context.Context, client *sendgrid.Client, email stri
 
 	return nil, nil
 }
+
+// lookupTeammate gets a teammate by an ID that is either a username or an email.
+// The teammate get endpoint is keyed by username, which may differ from the email,
+// so the ID is tried as a username first and otherwise resolved as an email to a username.
+func lookupTeammate[T any](
+	ctx context.Context,
+	id string,
+	get func(ctx context.Context, username string) (T, error),
+	usernameByEmail func(ctx context.Context, email string) (string, error),
+) (T, error) {
+	var zero T
+
+	teammate, getErr := get(ctx, id)
+	if getErr == nil {
+		return teammate, nil
+	}
+
+	username, err := usernameByEmail(ctx, id)
+	if err != nil {
+		return zero, err
+	}
+	if username == "" {
+		return zero, fmt.Errorf("no teammate has the username or email %s: %w", id, getErr)
+	}
+
+	return get(ctx, username)
+}
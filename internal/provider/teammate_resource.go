@@ -9,10 +9,15 @@ import (
 	"slices"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/i10416/sendgrid"
 	"github.com/i10416/terraform-provider-sendgrid-plus/flex"
@@ -21,6 +26,8 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &teammateResource{}
 var _ resource.ResourceWithImportState = &teammateResource{}
+var _ resource.ResourceWithValidateConfig = &teammateResource{}
+var _ resource.ResourceWithModifyPlan = &teammateResource{}
 
 var autoScopes = []string{
 	"2fa_exempt",
@@ -35,12 +42,90 @@ var scopesBlockedDuringInvitation = []string{
 	"user.password.update",
 }
 
+// Names of the scope presets that the role attribute expands to, in the order they are documented.
+var teammateRoleNames = []string{"developer", "marketing", "billing"}
+
+// Curated least-privilege scope presets. The key is the role and the value is the list of scopes it grants.
+var teammateRoles = map[string][]string{
+	"developer": {
+		"api_keys.read",
+		"mail.send",
+		"stats.read",
+		"templates.create",
+		"templates.read",
+		"templates.update",
+		"templates.delete",
+		"user.webhooks.event.settings.read",
+		"user.webhooks.event.settings.update",
+		"user.webhooks.parse.settings.read",
+	},
+	"marketing": {
+		"categories.read",
+		"marketing_campaigns.create",
+		"marketing_campaigns.read",
+		"marketing_campaigns.update",
+		"marketing_campaigns.delete",
+		"stats.read",
+		"suppression.read",
+		"templates.read",
+	},
+	"billing": {
+		"billing.read",
+		"billing.update",
+		"stats.read",
+	},
+}
+
+// Scopes that SendGrid grants implicitly alongside a write scope.
+// The key is the granted scope and the value is the list of scopes added by SendGrid.
+var impliedScopes = map[string][]string{
+	"mail.send":                           {"mail.batch.read"},
+	"mail.batch.create":                   {"mail.batch.read"},
+	"mail.batch.update":                   {"mail.batch.read"},
+	"mail.batch.delete":                   {"mail.batch.read"},
+	"alerts.create":                       {"alerts.read"},
+	"alerts.update":                       {"alerts.read"},
+	"alerts.delete":                       {"alerts.read"},
+	"api_keys.create":                     {"api_keys.read"},
+	"api_keys.update":                     {"api_keys.read"},
+	"api_keys.delete":                     {"api_keys.read"},
+	"asm.groups.create":                   {"asm.groups.read"},
+	"asm.groups.update":                   {"asm.groups.read"},
+	"asm.groups.delete":                   {"asm.groups.read"},
+	"suppression.create":                  {"suppression.read"},
+	"suppression.delete":                  {"suppression.read"},
+	"templates.create":                    {"templates.read"},
+	"templates.update":                    {"templates.read"},
+	"templates.delete":                    {"templates.read"},
+	"templates.versions.create":           {"templates.versions.read"},
+	"templates.versions.update":           {"templates.versions.read"},
+	"templates.versions.delete":           {"templates.versions.read"},
+	"templates.versions.activate.create":  {"templates.versions.activate.read"},
+	"templates.versions.activate.update":  {"templates.versions.activate.read"},
+	"templates.versions.activate.delete":  {"templates.versions.activate.read"},
+	"user.webhooks.event.settings.update": {"user.webhooks.event.settings.read"},
+	"user.webhooks.parse.settings.create": {"user.webhooks.parse.settings.read"},
+	"user.webhooks.parse.settings.update": {"user.webhooks.parse.settings.read"},
+	"user.webhooks.parse.settings.delete": {"user.webhooks.parse.settings.read"},
+	"whitelabel.create":                   {"whitelabel.read"},
+	"whitelabel.update":                   {"whitelabel.read"},
+	"whitelabel.delete":                   {"whitelabel.read"},
+}
+
+// Phrases of the messages SendGrid returns when inviting a teammate would exceed the account's teammate seats.
+var teammateSeatLimitMessages = []string{
+	"maximum number of teammates",
+	"teammate limit",
+	"limit of teammates",
+}
+
 func newTeammateResource() resource.Resource {
 	return &teammateResource{}
 }
 
 type teammateResource struct {
-	client *sendgrid.Client
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
 }
 
 type teammateResourceModel struct {
@@ -48,7 +133,10 @@ type teammateResourceModel struct {
 	Email    types.String   `tfsdk:"email"`
 	IsAdmin  types.Bool     `tfsdk:"is_admin"`
 	Scopes   []types.String `tfsdk:"scopes"`
+	Role     types.String   `tfsdk:"role"`
 	Username types.String   `tfsdk:"username"`
+
+	ReportImpliedScopes types.Bool `tfsdk:"report_implied_scopes"`
 }
 
 func (r *teammateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -69,8 +157,11 @@ For more detailed information, please see the [SendGrid documentation](https://d
 				Computed: true,
 			},
 			"email": schema.StringAttribute{
-				MarkdownDescription: "Teammate's email",
+				MarkdownDescription: "Teammate's email. SendGrid may normalize the case of the email, so emails differing only in case are considered the same.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					teammateEmailCaseInsensitive{},
+				},
 			},
 			"username": schema.StringAttribute{
 				MarkdownDescription: "Teammate's username. If the username you provide is already associated with an existing SendGrid account or teammate, the request will fail.",
@@ -99,39 +190,153 @@ These scopes can be added after the teammate accepts the invitation. To work aro
 
 Please note that SendGrid API behavior may change without notice.
 If you encounter any issues, feel free to report them via [issues](https://github.com/i10416/terraform-provider-sendgrid-plus/issues).
+
+Scopes are a set, so their order does not matter and SendGrid returning them in a different order does not produce a diff.
+
+Conflicts with ` + "`role`" + `, which sets the scopes of its preset.
 `,
-				Required: true,
+				Optional: true,
+				Computed: true,
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "A named preset of scopes granted to the teammate. Can be one of " + flex.QuoteAndJoin(teammateRoleNames) + ". Conflicts with `scopes` and `is_admin`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringOneOf(teammateRoleNames...),
+				},
+			},
+			"report_implied_scopes": schema.BoolAttribute{
+				MarkdownDescription: "Set to true to report, as a warning on refresh, the scopes SendGrid granted implicitly alongside the configured scopes. Such scopes are not stored in `scopes`, so this shows the teammate's effective permissions. Defaults to false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
 			},
 		},
 	}
 }
 
+func (r *teammateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var isAdmin types.Bool
+	var scopes types.Set
+	var role types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("is_admin"), &isAdmin)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("scopes"), &scopes)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("role"), &role)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !role.IsNull() && !scopes.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("role"),
+			"Invalid teammate role",
+			"role and scopes are mutually exclusive, set either of them.",
+		)
+		return
+	}
+	if !role.IsNull() && isAdmin.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("role"),
+			"Invalid teammate role",
+			"role must not be set for administrators, who have all scopes.",
+		)
+		return
+	}
+
+	if !isAdmin.ValueBool() || scopes.IsNull() || scopes.IsUnknown() {
+		return
+	}
+
+	// administrators have all scopes, so scopes must not be set for them.
+	if len(scopes.Elements()) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("scopes"),
+			"Invalid teammate scopes",
+			"scopes must be empty for administrators, who have all scopes.",
+		)
+	}
+}
+
+func (r *teammateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var role types.String
+	var scopes types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("role"), &role)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("scopes"), &scopes)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The configured scopes are planned as is.
+	if role.IsUnknown() || !scopes.IsNull() {
+		return
+	}
+
+	// A role expands to the scopes of its preset, and no scopes are granted without one.
+	planned := []string{}
+	if !role.IsNull() {
+		planned = teammateRoles[role.ValueString()]
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("scopes"), planned)...)
+}
+
+// plannedTeammateScopesKnown reports whether the planned scopes are known, adding an error if they are not.
+// ModifyPlan leaves the scopes unknown while the role they expand from is unknown, and the model cannot hold
+// unknown scopes, so they are read as a set first to fail with a clear error instead of a conversion error.
+func plannedTeammateScopesKnown(ctx context.Context, plan tfsdk.Plan, diags *diag.Diagnostics, summary string) bool {
+	var scopes types.Set
+	diags.Append(plan.GetAttribute(ctx, path.Root("scopes"), &scopes)...)
+	if diags.HasError() {
+		return false
+	}
+	if scopes.IsUnknown() || slices.ContainsFunc(scopes.Elements(), attr.Value.IsUnknown) {
+		diags.AddAttributeError(
+			path.Root("scopes"),
+			summary,
+			"Unable to apply unknown scopes. The scopes, or the role they are expanded from, must be known when applying.",
+		)
+		return false
+	}
+	return true
+}
+
 func (r *teammateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*sendgrid.Client)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+	r.rateLimits = data.rateLimits
 }
 
 func (r *teammateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !plannedTeammateScopesKnown(ctx, req.Plan, &resp.Diagnostics, "Creating teammate") {
+		return
+	}
+
 	var data teammateResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "create", data.ID)
+
 	// adminitors have all scopes, so we don't need to set them.
 	if data.IsAdmin.ValueBool() && len(data.Scopes) > 0 {
 		resp.Diagnostics.AddError(
@@ -177,13 +382,32 @@ func (r *teammateResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	// NOTE: Re-execute after the re-executable time has elapsed when a rate limit occurs
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
+	res, err := r.rateLimits.retryNonIdempotent(ctx, rateLimitGroupTeammates, func() (interface{}, error) {
 		return r.client.InviteTeammate(context.TODO(), input)
 	})
+	if scope, ok := unavailableTeammateScope(err, scopes); ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("scopes"),
+			"Creating teammate",
+			unavailableTeammateScopeDetail("invite teammate", scope, err),
+		)
+		return
+	}
+	if isTeammateSeatLimitError(err) {
+		resp.Diagnostics.AddError(
+			"Creating teammate",
+			fmt.Sprintf(
+				"Unable to invite teammate because the account has reached its teammate seat limit. "+
+					"Upgrade your SendGrid plan or remove an existing teammate, then try again. Got error: %s",
+				sendgridErrorDetail(err),
+			),
+		)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Creating teammate",
-			fmt.Sprintf("Unable to invite teammate, got error: %s", err),
+			fmt.Sprintf("Unable to invite teammate, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -199,20 +423,18 @@ func (r *teammateResource) Create(ctx context.Context, req resource.CreateReques
 
 	scopesSet := []types.String{}
 	if !inviteTeammate.IsAdmin {
-		for _, s := range inviteTeammate.Scopes {
-			if slices.Contains(autoScopes, s) {
-				continue
-			}
-			scopesSet = append(scopesSet, types.StringValue(s))
-		}
+		scopesSet = normalizeTeammateScopes(data.Scopes, inviteTeammate.Scopes)
 	}
 
 	// pending user does not have an username.
 	data = teammateResourceModel{
 		ID:      types.StringValue(inviteTeammate.Email),
-		Email:   types.StringValue(inviteTeammate.Email),
+		Email:   normalizeTeammateEmail(data.Email, inviteTeammate.Email),
 		IsAdmin: types.BoolValue(inviteTeammate.IsAdmin),
 		Scopes:  scopesSet,
+		Role:    data.Role,
+
+		ReportImpliedScopes: data.ReportImpliedScopes,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -228,13 +450,19 @@ func (r *teammateResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "read", data.ID)
+	// States written before report_implied_scopes existed have no value for it.
+	if data.ReportImpliedScopes.IsNull() {
+		data.ReportImpliedScopes = types.BoolValue(false)
+	}
+
 	email := data.Email.ValueString()
 
 	pendingTeammate, err := pendingTeammateByEmail(ctx, r.client, email)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading teammate",
-			fmt.Sprintf("Unable to get pending teammates, got error: %s", err),
+			fmt.Sprintf("Unable to get pending teammates, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -244,16 +472,11 @@ func (r *teammateResource) Read(ctx context.Context, req resource.ReadRequest, r
 		scopes := []types.String{}
 		// administorators have all scopes, so we don't need to set them.
 		if !data.IsAdmin.ValueBool() {
-			for _, s := range pendingTeammate.Scopes {
-				if slices.Contains(autoScopes, s) {
-					continue
-				}
-				scopes = append(scopes, types.StringValue(s))
-			}
+			scopes = normalizeTeammateScopes(data.Scopes, pendingTeammate.Scopes)
 		}
 		data = teammateResourceModel{
 			ID:    types.StringValue(pendingTeammate.Email),
-			Email: types.StringValue(pendingTeammate.Email),
+			Email: normalizeTeammateEmail(data.Email, pendingTeammate.Email),
 			// NOTE: As per the SendGrid API specifications,
 			//       pending teammates cannot update the administrator flag.
 			//       In such cases, discrepancies arise between the Terraform code and the tfstate,
@@ -263,6 +486,9 @@ func (r *teammateResource) Read(ctx context.Context, req resource.ReadRequest, r
 			//       not accommodating the above would hinder team member management, making it unavoidable.
 			IsAdmin: data.IsAdmin,
 			Scopes:  scopes,
+			Role:    data.Role,
+
+			ReportImpliedScopes: data.ReportImpliedScopes,
 		}
 
 		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -273,7 +499,7 @@ func (r *teammateResource) Read(ctx context.Context, req resource.ReadRequest, r
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading teammate",
-			fmt.Sprintf("Unable to read teammate (%s), got error: %s", email, err),
+			fmt.Sprintf("Unable to read teammate (%s), got error: %s", email, sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -289,29 +515,36 @@ func (r *teammateResource) Read(ctx context.Context, req resource.ReadRequest, r
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading teammate",
-			fmt.Sprintf("Unable to read teammate (username: %s), got error: %s", teammateByEmail.Username, err),
+			fmt.Sprintf("Unable to read teammate (username: %s), got error: %s", teammateByEmail.Username, sendgridErrorDetail(err)),
 		)
 		return
 	}
 
 	scopes := []types.String{}
-	// admin users have all scopes, so we don't need to set them.
+	// admin users have all scopes, so the scopes returned for them are not managed
+	// and the tfstate keeps an empty set, as required by the configuration.
 	if !o.IsAdmin {
-		for _, s := range o.Scopes {
-			// Automatically assigned scopes in SendGrid are not managed.
-			if slices.Contains(autoScopes, s) {
-				continue
-			}
-			scopes = append(scopes, types.StringValue(s))
+		scopes = normalizeTeammateScopes(data.Scopes, o.Scopes)
+	}
+
+	if data.ReportImpliedScopes.ValueBool() && !o.IsAdmin {
+		if extra := impliedTeammateScopes(data.Scopes, o.Scopes); len(extra) > 0 {
+			resp.Diagnostics.AddWarning(
+				"Teammate has implied scopes",
+				fmt.Sprintf("SendGrid granted %s (username: %s) the following scopes implied by the configured scopes, which are not stored in state: %s", email, o.Username, strings.Join(extra, ", ")),
+			)
 		}
 	}
 
 	data = teammateResourceModel{
 		ID:       types.StringValue(o.Email),
-		Email:    types.StringValue(o.Email),
+		Email:    normalizeTeammateEmail(data.Email, o.Email),
 		IsAdmin:  types.BoolValue(o.IsAdmin),
 		Username: types.StringValue(o.Username),
 		Scopes:   scopes,
+		Role:     data.Role,
+
+		ReportImpliedScopes: data.ReportImpliedScopes,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -321,6 +554,10 @@ func (r *teammateResource) Read(ctx context.Context, req resource.ReadRequest, r
 }
 
 func (r *teammateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !plannedTeammateScopesKnown(ctx, req.Plan, &resp.Diagnostics, "Updating teammate") {
+		return
+	}
+
 	var data, state teammateResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -328,6 +565,8 @@ func (r *teammateResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "update", data.ID)
+
 	// adminitors have all scopes, so we don't need to set them.
 	if data.IsAdmin.ValueBool() && len(data.Scopes) > 0 {
 		resp.Diagnostics.AddError(
@@ -343,7 +582,7 @@ func (r *teammateResource) Update(ctx context.Context, req resource.UpdateReques
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Updating teammate",
-			fmt.Sprintf("Unable to get pending teammates, got error: %s", err),
+			fmt.Sprintf("Unable to get pending teammates, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -356,7 +595,7 @@ func (r *teammateResource) Update(ctx context.Context, req resource.UpdateReques
 		}
 		p := teammateResourceModel{
 			ID:    types.StringValue(pendingTeammate.Email),
-			Email: types.StringValue(pendingTeammate.Email),
+			Email: normalizeTeammateEmail(data.Email, pendingTeammate.Email),
 			// NOTE: As per the SendGrid API specifications,
 			//       pending teammates cannot update the administrator flag and scopes.
 			//       In such cases, discrepancies arise between the Terraform code and the tfstate,
@@ -366,6 +605,9 @@ func (r *teammateResource) Update(ctx context.Context, req resource.UpdateReques
 			//       not accommodating the above would hinder team member management, making it unavoidable.
 			IsAdmin: data.IsAdmin,
 			Scopes:  scopes,
+			Role:    data.Role,
+
+			ReportImpliedScopes: data.ReportImpliedScopes,
 		}
 		resp.Diagnostics.Append(resp.State.Set(ctx, &p)...)
 		return
@@ -391,36 +633,80 @@ func (r *teammateResource) Update(ctx context.Context, req resource.UpdateReques
 		scopes = append(scopes, s.ValueString())
 	}
 
-	o, err := r.client.UpdateTeammatePermissions(ctx, username, &sendgrid.InputUpdateTeammatePermissions{
-		IsAdmin: data.IsAdmin.ValueBool(),
-		Scopes:  scopes,
+	current := []string{}
+	for _, s := range state.Scopes {
+		current = append(current, s.ValueString())
+	}
+	// NOTE: A scope removed and re-added in the same plan is no net change, so skip the API call
+	//       when neither the effective scope set nor the administrator flag changes.
+	if !teammatePermissionsChanged(data.IsAdmin.ValueBool(), state.IsAdmin.ValueBool(), scopes, current) {
+		scopesSet := []types.String{}
+		if !state.IsAdmin.ValueBool() {
+			scopesSet = data.Scopes
+		}
+		data = teammateResourceModel{
+			ID:       state.ID,
+			Email:    normalizeTeammateEmail(data.Email, state.Email.ValueString()),
+			IsAdmin:  state.IsAdmin,
+			Username: state.Username,
+			Scopes:   scopesSet,
+			Role:     data.Role,
+
+			ReportImpliedScopes: data.ReportImpliedScopes,
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	// NOTE: SendGrid does not support adding or removing individual scopes of a teammate.
+	//       Permissions are replaced as a whole, so the new scope set is sent atomically in a single request
+	//       and the teammate never goes through a state with fewer scopes than both the old and new sets share.
+	res, err := r.rateLimits.retry(ctx, rateLimitGroupTeammates, func() (interface{}, error) {
+		return r.client.UpdateTeammatePermissions(ctx, username, &sendgrid.InputUpdateTeammatePermissions{
+			IsAdmin: data.IsAdmin.ValueBool(),
+			Scopes:  scopes,
+		})
 	})
+	if scope, ok := unavailableTeammateScope(err, scopes); ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("scopes"),
+			"Updating teammate",
+			unavailableTeammateScopeDetail("update teammate permissions", scope, err),
+		)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Updating teammate",
-			fmt.Sprintf("Unable to update teammate permissions, got error: %s", err),
+			fmt.Sprintf("Unable to update teammate permissions, got error: %s", sendgridErrorDetail(err)),
+		)
+		return
+	}
+
+	o, ok := res.(*sendgrid.OutputUpdateTeammatePermissions)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Updating teammate",
+			"Failed to assert type *sendgrid.OutputUpdateTeammatePermissions",
 		)
 		return
 	}
 
 	scopesSet := []types.String{}
 	if !o.IsAdmin {
-		for _, s := range o.Scopes {
-			// If scopes automatically added by SendGrid is specified, the process should fail.
-			if slices.Contains(autoScopes, s) {
-				continue
-			}
-			scopesSet = append(scopesSet, types.StringValue(s))
-		}
+		scopesSet = normalizeTeammateScopes(data.Scopes, o.Scopes)
 	}
 
 	// Save updated data into Terraform state
 	data = teammateResourceModel{
 		ID:       types.StringValue(o.Email),
-		Email:    types.StringValue(o.Email),
+		Email:    normalizeTeammateEmail(data.Email, o.Email),
 		IsAdmin:  types.BoolValue(o.IsAdmin),
 		Username: types.StringValue(o.Username),
 		Scopes:   scopesSet,
+		Role:     data.Role,
+
+		ReportImpliedScopes: data.ReportImpliedScopes,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -436,16 +722,18 @@ func (r *teammateResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "delete", data.ID)
+
 	email := data.Email.ValueString()
 
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
+	res, err := r.rateLimits.retry(ctx, rateLimitGroupTeammates, func() (interface{}, error) {
 		// Invited users are treated as pending users until they set up their profiles.
 		return pendingTeammateByEmail(ctx, r.client, email)
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Deleting teammate",
-			fmt.Sprintf("Unable to get pending teammates, got error: %s", err),
+			fmt.Sprintf("Unable to get pending teammates, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -460,26 +748,26 @@ func (r *teammateResource) Delete(ctx context.Context, req resource.DeleteReques
 	}
 
 	if pendingUser != nil {
-		_, err = retryOnRateLimit(ctx, func() (interface{}, error) {
+		_, err = r.rateLimits.retry(ctx, rateLimitGroupTeammates, func() (interface{}, error) {
 			return nil, r.client.DeletePendingTeammate(ctx, pendingUser.Token)
 		})
 		// If the teammate is in a pending state, execute the API to remove pending teammates.
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Deleting teammate",
-				fmt.Sprintf("Unable to delete pending teammate, got error: %s", err),
+				fmt.Sprintf("Unable to delete pending teammate, got error: %s", sendgridErrorDetail(err)),
 			)
 		}
 		return
 	}
 
-	res, err = retryOnRateLimit(ctx, func() (interface{}, error) {
+	res, err = r.rateLimits.retry(ctx, rateLimitGroupTeammates, func() (interface{}, error) {
 		return getTeammateByEmail(ctx, r.client, email)
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Deleting teammate",
-			fmt.Sprintf("Unable to get teammates, got error: %s", err),
+			fmt.Sprintf("Unable to get teammates, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -501,7 +789,7 @@ func (r *teammateResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	_, err = retryOnRateLimit(ctx, func() (interface{}, error) {
+	_, err = r.rateLimits.retry(ctx, rateLimitGroupTeammates, func() (interface{}, error) {
 		return nil, r.client.DeleteTeammate(ctx, teammateByEmail.Username)
 	})
 
@@ -511,7 +799,7 @@ func (r *teammateResource) Delete(ctx context.Context, req resource.DeleteReques
 			fmt.Sprintf(
 				"Could not delete teammate %s, unexpected error: %s",
 				teammateByEmail.Username,
-				err,
+				sendgridErrorDetail(err),
 			),
 		)
 		return
@@ -519,6 +807,8 @@ func (r *teammateResource) Delete(ctx context.Context, req resource.DeleteReques
 }
 
 func (r *teammateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = withAPILogFields(ctx, "import", types.StringValue(req.ID))
+
 	var data teammateResourceModel
 
 	email := req.ID
@@ -529,7 +819,7 @@ func (r *teammateResource) ImportState(ctx context.Context, req resource.ImportS
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Importing teammate",
-			fmt.Sprintf("Unable to get pending teammates, got error: %s", err),
+			fmt.Sprintf("Unable to get pending teammates, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -550,34 +840,27 @@ func (r *teammateResource) ImportState(ctx context.Context, req resource.ImportS
 			Email:   types.StringValue(email),
 			IsAdmin: types.BoolValue(pendingTeammate.IsAdmin),
 			Scopes:  scopes,
+			Role:    types.StringNull(),
+
+			ReportImpliedScopes: types.BoolValue(false),
 		}
 
 		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 		return
 	}
 
-	teammateByEmail, err := getTeammateByEmail(ctx, r.client, email)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Importing teammate",
-			fmt.Sprintf("Unable to read teammate (%s), got error: %s", email, err),
-		)
-		return
-	}
-
-	if teammateByEmail == nil {
-		resp.Diagnostics.AddError(
-			"Importing teammate",
-			fmt.Sprintf("Not found teammate (%s)", email),
-		)
-		return
-	}
-
-	teammate, err := r.client.GetTeammate(ctx, teammateByEmail.Username)
+	// The import ID is either the teammate's username or email.
+	teammate, err := lookupTeammate(ctx, email, r.client.GetTeammate, func(ctx context.Context, email string) (string, error) {
+		t, err := getTeammateByEmail(ctx, r.client, email)
+		if err != nil || t == nil {
+			return "", err
+		}
+		return t.Username, nil
+	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Importing teammate",
-			fmt.Sprintf("Unable to read teammate, got error: %s", err),
+			fmt.Sprintf("Unable to read teammate (%s), got error: %s", email, sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -599,6 +882,9 @@ func (r *teammateResource) ImportState(ctx context.Context, req resource.ImportS
 		IsAdmin:  types.BoolValue(teammate.IsAdmin),
 		Username: types.StringValue(teammate.Username),
 		Scopes:   scopes,
+		Role:     types.StringNull(),
+
+		ReportImpliedScopes: types.BoolValue(false),
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -606,3 +892,143 @@ func (r *teammateResource) ImportState(ctx context.Context, req resource.ImportS
 		return
 	}
 }
+
+// normalizeTeammateScopes converts the scopes returned by SendGrid into the scopes managed by the resource.
+// Scopes assigned automatically by SendGrid and read scopes implied by a configured write scope are dropped
+// unless they are configured explicitly, so that they do not produce a diff.
+func normalizeTeammateScopes(configured []types.String, remote []string) []types.String {
+	want := map[string]struct{}{}
+	implied := map[string]struct{}{}
+	for _, s := range configured {
+		want[s.ValueString()] = struct{}{}
+		for _, i := range impliedScopes[s.ValueString()] {
+			implied[i] = struct{}{}
+		}
+	}
+
+	scopes := []types.String{}
+	for _, s := range remote {
+		// Automatically assigned scopes in SendGrid are not managed.
+		if slices.Contains(autoScopes, s) {
+			continue
+		}
+		if _, ok := implied[s]; ok {
+			if _, ok := want[s]; !ok {
+				continue
+			}
+		}
+		scopes = append(scopes, types.StringValue(s))
+	}
+	return scopes
+}
+
+// impliedTeammateScopes returns the scopes in remote that SendGrid granted implicitly alongside the configured scopes,
+// that is, the scopes that make the granted scopes a strict superset of the configured ones.
+func impliedTeammateScopes(configured []types.String, remote []string) []string {
+	want := map[string]struct{}{}
+	implied := map[string]struct{}{}
+	for _, s := range configured {
+		want[s.ValueString()] = struct{}{}
+		for _, i := range impliedScopes[s.ValueString()] {
+			implied[i] = struct{}{}
+		}
+	}
+
+	extra := []string{}
+	for _, s := range remote {
+		if _, ok := want[s]; ok {
+			continue
+		}
+		if _, ok := implied[s]; ok && !slices.Contains(extra, s) {
+			extra = append(extra, s)
+		}
+	}
+	slices.Sort(extra)
+	return extra
+}
+
+// normalizeTeammateEmail returns the email read from SendGrid, or the configured email if they differ only in case,
+// so that SendGrid lowercasing an invited email does not produce a diff.
+func normalizeTeammateEmail(configured types.String, remote string) types.String {
+	if !configured.IsNull() && !configured.IsUnknown() && strings.EqualFold(configured.ValueString(), remote) {
+		return configured
+	}
+	return types.StringValue(remote)
+}
+
+// teammateEmailCaseInsensitive keeps the email in state when the configured email differs from it only in case.
+type teammateEmailCaseInsensitive struct{}
+
+func (m teammateEmailCaseInsensitive) Description(ctx context.Context) string {
+	return "Ignores changes to the email that only differ in case."
+}
+
+func (m teammateEmailCaseInsensitive) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m teammateEmailCaseInsensitive) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.StateValue.IsNull() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	if strings.EqualFold(req.StateValue.ValueString(), req.PlanValue.ValueString()) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+// isTeammateSeatLimitError reports whether err is the error SendGrid returns when the account has no teammate seats left.
+// Only the messages of the errors in the response body are matched, so that other errors mentioning a limit are not.
+func isTeammateSeatLimitError(err error) bool {
+	for _, e := range sendgridFieldErrors(err) {
+		msg := strings.ToLower(e.Message)
+		for _, m := range teammateSeatLimitMessages {
+			if strings.Contains(msg, m) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// unavailableTeammateScope reports the requested scope SendGrid rejected as unavailable on the account's plan.
+// SendGrid does not return a structured error for this, so the scope is looked up in the messages of the
+// errors in the response body that refer to scopes, either by their field or by their message.
+func unavailableTeammateScope(err error, scopes []string) (string, bool) {
+	found := ""
+	for _, e := range sendgridFieldErrors(err) {
+		msg := strings.ToLower(e.Message)
+		if e.Field != "scopes" && !strings.Contains(msg, "scope") {
+			continue
+		}
+		// Prefer the longest match, since a scope name can contain another one.
+		for _, s := range scopes {
+			if strings.Contains(msg, strings.ToLower(s)) && len(s) > len(found) {
+				found = s
+			}
+		}
+	}
+	return found, found != ""
+}
+
+func unavailableTeammateScopeDetail(action, scope string, err error) string {
+	return fmt.Sprintf(
+		"Unable to %s, the scope '%s' is not available on this account. "+
+			"It may require a SendGrid plan upgrade. Got error: %s",
+		action, scope, sendgridErrorDetail(err),
+	)
+}
+
+// teammatePermissionsChanged reports whether updating from the current to the desired permissions
+// changes anything, comparing scopes as sets.
+func teammatePermissionsChanged(desiredIsAdmin, currentIsAdmin bool, desired, current []string) bool {
+	if desiredIsAdmin != currentIsAdmin {
+		return true
+	}
+	// NOTE: Scopes are not sent for administrators, so only the flag matters.
+	if desiredIsAdmin {
+		return false
+	}
+	toAdd, toRemove := diffSets(desired, current)
+	return len(toAdd) > 0 || len(toRemove) > 0
+}
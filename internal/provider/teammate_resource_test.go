@@ -4,12 +4,25 @@
 package provider
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/i10416/sendgrid"
 )
 
 func TestAccTeammateResource(t *testing.T) {
@@ -41,6 +54,519 @@ func TestAccTeammateResource(t *testing.T) {
 	})
 }
 
+func TestAccTeammateResourceAdmin(t *testing.T) {
+	resourceName := "sendgrid_teammate.test"
+
+	email := fmt.Sprintf("test-acc-%s@example.com", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Scopes are rejected at plan time for administrators
+			{
+				Config:      testAccTeammateResourceAdminConfig(email, []string{"user.profile.read"}),
+				ExpectError: regexp.MustCompile("scopes must be empty for administrators"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccTeammateResourceAdminConfig(email, []string{}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "email", email),
+					resource.TestCheckResourceAttr(resourceName, "is_admin", "true"),
+					resource.TestCheckResourceAttr(resourceName, "scopes.#", "0"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTeammateResourceRole(t *testing.T) {
+	resourceName := "sendgrid_teammate.test"
+
+	email := fmt.Sprintf("test-acc-%s@example.com", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// role and scopes are rejected together at plan time
+			{
+				Config: fmt.Sprintf(`
+resource "sendgrid_teammate" "test" {
+	email  = "%s"
+	role   = "billing"
+	scopes = ["stats.read"]
+}
+`, email),
+				ExpectError: regexp.MustCompile("role and scopes are mutually exclusive"),
+			},
+			// Create and Read testing
+			{
+				Config: fmt.Sprintf(`
+resource "sendgrid_teammate" "test" {
+	email = "%s"
+	role  = "billing"
+}
+`, email),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "role", "billing"),
+					resource.TestCheckResourceAttr(resourceName, "scopes.#", fmt.Sprint(len(teammateRoles["billing"]))),
+					resource.TestCheckTypeSetElemAttr(resourceName, "scopes.*", "billing.read"),
+				),
+			},
+		},
+	})
+}
+
+func TestTeammateRoles(t *testing.T) {
+	if len(teammateRoles) != len(teammateRoleNames) {
+		t.Errorf("got %d presets, want one per role name %v", len(teammateRoles), teammateRoleNames)
+	}
+
+	for _, role := range teammateRoleNames {
+		scopes, ok := teammateRoles[role]
+		if !ok || len(scopes) == 0 {
+			t.Errorf("role %q has no scopes", role)
+		}
+		for _, s := range scopes {
+			// Presets must be assignable when inviting a teammate.
+			if slices.Contains(autoScopes, s) || slices.Contains(scopesBlockedDuringInvitation, s) {
+				t.Errorf("role %q grants the scope %q, which cannot be assigned on invitation", role, s)
+			}
+		}
+	}
+}
+
+func TestTeammateResourceModifyPlanExpandsRole(t *testing.T) {
+	ctx := context.Background()
+	r := &teammateResource{}
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	s := schemaResp.Schema
+	typ := s.Type().TerraformType(ctx)
+	scopesType := tftypes.Set{ElementType: tftypes.String}
+
+	value := func(role, scopes tftypes.Value, unknownComputed bool) tftypes.Value {
+		computed := tftypes.NewValue(tftypes.String, nil)
+		if unknownComputed {
+			computed = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+		}
+		return tftypes.NewValue(typ, map[string]tftypes.Value{
+			"id":       computed,
+			"email":    tftypes.NewValue(tftypes.String, "test@example.com"),
+			"username": computed,
+			"is_admin": tftypes.NewValue(tftypes.Bool, false),
+			"scopes":   scopes,
+			"role":     role,
+
+			"report_implied_scopes": tftypes.NewValue(tftypes.Bool, false),
+		})
+	}
+
+	cases := []struct {
+		name string
+		role tftypes.Value
+		want []string
+	}{
+		{name: "role", role: tftypes.NewValue(tftypes.String, "billing"), want: teammateRoles["billing"]},
+		{name: "no role", role: tftypes.NewValue(tftypes.String, nil), want: []string{}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			plan := tfsdk.Plan{Schema: s, Raw: value(c.role, tftypes.NewValue(scopesType, tftypes.UnknownValue), true)}
+			resp := &fwresource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: s, Raw: value(c.role, tftypes.NewValue(scopesType, nil), false)},
+				Plan:   plan,
+				State:  tfsdk.State{Schema: s, Raw: tftypes.NewValue(typ, nil)},
+			}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			var got []string
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("scopes"), &got)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			slices.Sort(got)
+			want := slices.Sorted(slices.Values(c.want))
+			if !slices.Equal(got, want) {
+				t.Errorf("got scopes %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestTeammateResourceUnknownRole(t *testing.T) {
+	ctx := context.Background()
+	r := &teammateResource{}
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	s := schemaResp.Schema
+	typ := s.Type().TerraformType(ctx)
+	scopesType := tftypes.Set{ElementType: tftypes.String}
+	unknownRole := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+
+	value := func(scopes tftypes.Value, computed tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(typ, map[string]tftypes.Value{
+			"id":       computed,
+			"email":    tftypes.NewValue(tftypes.String, "test@example.com"),
+			"username": computed,
+			"is_admin": tftypes.NewValue(tftypes.Bool, false),
+			"scopes":   scopes,
+			"role":     unknownRole,
+
+			"report_implied_scopes": tftypes.NewValue(tftypes.Bool, false),
+		})
+	}
+
+	// The role is only known after apply, so the scopes it expands to stay unknown.
+	plan := tfsdk.Plan{Schema: s, Raw: value(tftypes.NewValue(scopesType, tftypes.UnknownValue), tftypes.NewValue(tftypes.String, tftypes.UnknownValue))}
+	modifyResp := &fwresource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: s, Raw: value(tftypes.NewValue(scopesType, nil), tftypes.NewValue(tftypes.String, nil))},
+		Plan:   plan,
+		State:  tfsdk.State{Schema: s, Raw: tftypes.NewValue(typ, nil)},
+	}, modifyResp)
+	if modifyResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", modifyResp.Diagnostics)
+	}
+	var scopes types.Set
+	modifyResp.Diagnostics.Append(modifyResp.Plan.GetAttribute(ctx, path.Root("scopes"), &scopes)...)
+	if !scopes.IsUnknown() {
+		t.Errorf("got scopes %s, want them unknown", scopes)
+	}
+
+	// Applying unknown scopes fails with an error on scopes instead of a conversion error.
+	createResp := &fwresource.CreateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(typ, nil)}}
+	r.Create(ctx, fwresource.CreateRequest{Plan: modifyResp.Plan}, createResp)
+	if createResp.Diagnostics.ErrorsCount() != 1 {
+		t.Fatalf("got %v, want a single error", createResp.Diagnostics)
+	}
+	if d, ok := createResp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath); !ok || !d.Path().Equal(path.Root("scopes")) {
+		t.Errorf("got %v, want an error on scopes", createResp.Diagnostics.Errors()[0])
+	}
+}
+
+func TestNormalizeTeammateScopes(t *testing.T) {
+	cases := []struct {
+		name       string
+		configured []string
+		remote     []string
+		want       []string
+	}{
+		{
+			name:       "mail.send implies mail.batch.read",
+			configured: []string{"mail.send"},
+			remote:     []string{"mail.send", "mail.batch.read"},
+			want:       []string{"mail.send"},
+		},
+		{
+			name:       "templates.create implies templates.read",
+			configured: []string{"templates.create"},
+			remote:     []string{"templates.create", "templates.read", "2fa_required"},
+			want:       []string{"templates.create"},
+		},
+		{
+			name:       "several write scopes share one implied read scope",
+			configured: []string{"alerts.create", "alerts.update"},
+			remote:     []string{"alerts.create", "alerts.read", "alerts.update"},
+			want:       []string{"alerts.create", "alerts.update"},
+		},
+		{
+			name:       "implied scope kept when configured explicitly",
+			configured: []string{"mail.send", "mail.batch.read"},
+			remote:     []string{"mail.send", "mail.batch.read"},
+			want:       []string{"mail.send", "mail.batch.read"},
+		},
+		{
+			name:       "read scope kept when not implied by configuration",
+			configured: []string{"user.profile.read"},
+			remote:     []string{"user.profile.read", "templates.read"},
+			want:       []string{"user.profile.read", "templates.read"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var configured []types.String
+			for _, s := range c.configured {
+				configured = append(configured, types.StringValue(s))
+			}
+
+			var got []string
+			for _, s := range normalizeTeammateScopes(configured, c.remote) {
+				got = append(got, s.ValueString())
+			}
+
+			if !slices.Equal(got, c.want) {
+				t.Errorf("got %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeTeammateScopesReorderedResponse(t *testing.T) {
+	ctx := context.Background()
+	configured := []types.String{
+		types.StringValue("mail.send"),
+		types.StringValue("alerts.read"),
+		types.StringValue("templates.create"),
+	}
+	// SendGrid returns the scopes in its own order, with implied and automatic scopes mixed in.
+	remote := []string{"templates.read", "2fa_required", "templates.create", "mail.batch.read", "alerts.read", "mail.send"}
+
+	want, diags := types.SetValueFrom(ctx, types.StringType, configured)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	got, diags := types.SetValueFrom(ctx, types.StringType, normalizeTeammateScopes(configured, remote))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !got.Equal(want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestNormalizeTeammateEmail(t *testing.T) {
+	cases := []struct {
+		name       string
+		configured types.String
+		remote     string
+		want       types.String
+	}{
+		{name: "same", configured: types.StringValue("foo@example.com"), remote: "foo@example.com", want: types.StringValue("foo@example.com")},
+		{name: "lowercased by SendGrid", configured: types.StringValue("Foo@Example.com"), remote: "foo@example.com", want: types.StringValue("Foo@Example.com")},
+		{name: "different email", configured: types.StringValue("foo@example.com"), remote: "bar@example.com", want: types.StringValue("bar@example.com")},
+		{name: "not configured", configured: types.StringNull(), remote: "foo@example.com", want: types.StringValue("foo@example.com")},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := normalizeTeammateEmail(c.configured, c.remote); !got.Equal(c.want) {
+				t.Errorf("got %s, want %s", got, c.want)
+			}
+		})
+	}
+}
+
+func TestTeammateEmailCaseInsensitive(t *testing.T) {
+	cases := []struct {
+		name  string
+		state types.String
+		plan  types.String
+		want  types.String
+	}{
+		{name: "create", state: types.StringNull(), plan: types.StringValue("Foo@Example.com"), want: types.StringValue("Foo@Example.com")},
+		{name: "case only", state: types.StringValue("foo@example.com"), plan: types.StringValue("Foo@Example.com"), want: types.StringValue("foo@example.com")},
+		{name: "different email", state: types.StringValue("foo@example.com"), plan: types.StringValue("bar@example.com"), want: types.StringValue("bar@example.com")},
+		{name: "unknown", state: types.StringValue("foo@example.com"), plan: types.StringUnknown(), want: types.StringUnknown()},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			resp := &planmodifier.StringResponse{PlanValue: c.plan}
+			teammateEmailCaseInsensitive{}.PlanModifyString(context.Background(), planmodifier.StringRequest{
+				Path:       path.Root("email"),
+				StateValue: c.state,
+				PlanValue:  c.plan,
+			}, resp)
+
+			if !resp.PlanValue.Equal(c.want) {
+				t.Errorf("got %s, want %s", resp.PlanValue, c.want)
+			}
+		})
+	}
+}
+
+func TestIsTeammateSeatLimitError(t *testing.T) {
+	cases := []struct {
+		name   string
+		status int
+		body   any
+		want   bool
+	}{
+		{
+			name:   "maximum number of teammates",
+			status: http.StatusBadRequest,
+			body:   map[string]any{"errors": []map[string]any{{"field": nil, "message": "You have reached the maximum number of teammates for your plan"}}},
+			want:   true,
+		},
+		{
+			name:   "teammate limit",
+			status: http.StatusForbidden,
+			body:   map[string]any{"errors": []map[string]any{{"field": "email", "message": "Teammate limit reached"}}},
+			want:   true,
+		},
+		{
+			name:   "invalid email",
+			status: http.StatusBadRequest,
+			body:   map[string]any{"errors": []map[string]any{{"field": "email", "message": "invalid email"}}},
+		},
+		// Limits of other resources are not the teammate seats.
+		{
+			name:   "other limit",
+			status: http.StatusBadRequest,
+			body:   map[string]any{"errors": []map[string]any{{"field": nil, "message": "You have reached the maximum number of API keys"}}},
+		},
+		{name: "no body", status: http.StatusBadRequest},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rt := newMockTransport()
+			rt.Handle(http.MethodPost, "/v3/teammates", func(_ *http.Request) (int, any) {
+				return c.status, c.body
+			})
+			_, err := newMockClient(rt).InviteTeammate(context.Background(), &sendgrid.InputInviteTeammate{Email: "test@example.com"})
+			if err == nil {
+				t.Fatal("got no error, want the error response")
+			}
+
+			if got := isTeammateSeatLimitError(err); got != c.want {
+				t.Errorf("isTeammateSeatLimitError(%v) = %t, want %t", err, got, c.want)
+			}
+		})
+	}
+
+	if isTeammateSeatLimitError(nil) {
+		t.Error("isTeammateSeatLimitError(nil) = true, want false")
+	}
+}
+
+func TestUnavailableTeammateScope(t *testing.T) {
+	scopes := []string{"mail.send", "ips.read", "ips.pools.read"}
+	cases := []struct {
+		name      string
+		status    int
+		body      any
+		wantScope string
+		wantOk    bool
+	}{
+		{
+			name:   "invalid email",
+			status: http.StatusBadRequest,
+			body:   map[string]any{"errors": []map[string]any{{"field": "email", "message": "invalid email"}}},
+		},
+		{
+			name:      "scope unavailable on the plan",
+			status:    http.StatusBadRequest,
+			body:      map[string]any{"errors": []map[string]any{{"field": "scopes", "message": "ips.read is not available on your plan"}}},
+			wantScope: "ips.read",
+			wantOk:    true,
+		},
+		{
+			name:      "longest scope name",
+			status:    http.StatusForbidden,
+			body:      map[string]any{"errors": []map[string]any{{"field": nil, "message": "invalid scope: ips.pools.read"}}},
+			wantScope: "ips.pools.read",
+			wantOk:    true,
+		},
+		// Messages about the plan that do not refer to scopes are not about the requested scopes.
+		{
+			name:   "upgrade without a scope",
+			status: http.StatusForbidden,
+			body:   map[string]any{"errors": []map[string]any{{"field": nil, "message": "upgrade your plan to add teammates, mail.send is not allowed"}}},
+		},
+		// The scope cannot be identified, so the generic error is used.
+		{
+			name:   "scope field without a scope name",
+			status: http.StatusBadRequest,
+			body:   map[string]any{"errors": []map[string]any{{"field": "scopes", "message": "this feature is not available"}}},
+		},
+		{name: "no body", status: http.StatusForbidden},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rt := newMockTransport()
+			rt.Handle(http.MethodPost, "/v3/teammates", func(_ *http.Request) (int, any) {
+				return c.status, c.body
+			})
+			_, err := newMockClient(rt).InviteTeammate(context.Background(), &sendgrid.InputInviteTeammate{Email: "test@example.com", Scopes: scopes})
+			if err == nil {
+				t.Fatal("got no error, want the error response")
+			}
+
+			scope, ok := unavailableTeammateScope(err, scopes)
+			if scope != c.wantScope || ok != c.wantOk {
+				t.Errorf("unavailableTeammateScope(%v) = (%q, %t), want (%q, %t)", err, scope, ok, c.wantScope, c.wantOk)
+			}
+		})
+	}
+}
+
+func TestLookupTeammate(t *testing.T) {
+	type teammate struct{ username, email string }
+	teammates := []teammate{
+		{username: "alice", email: "alice@example.com"},
+		// The username may itself look like an email.
+		{username: "bob@example.com", email: "bob@example.com"},
+	}
+
+	get := func(_ context.Context, username string) (teammate, error) {
+		for _, tm := range teammates {
+			if tm.username == username {
+				return tm, nil
+			}
+		}
+		return teammate{}, errors.New("404 Not Found")
+	}
+	usernameByEmail := func(_ context.Context, email string) (string, error) {
+		for _, tm := range teammates {
+			if tm.email == email {
+				return tm.username, nil
+			}
+		}
+		return "", nil
+	}
+
+	cases := []struct {
+		id      string
+		want    string
+		wantErr bool
+	}{
+		{id: "alice", want: "alice"},
+		{id: "alice@example.com", want: "alice"},
+		{id: "bob@example.com", want: "bob@example.com"},
+		{id: "carol@example.com", wantErr: true},
+	}
+
+	for _, c := range cases {
+		got, err := lookupTeammate(context.Background(), c.id, get, usernameByEmail)
+		if (err != nil) != c.wantErr {
+			t.Errorf("lookupTeammate(%q) error = %v, want error %t", c.id, err, c.wantErr)
+			continue
+		}
+		if got.username != c.want {
+			t.Errorf("lookupTeammate(%q) = %q, want %q", c.id, got.username, c.want)
+		}
+	}
+}
+
+func TestLookupTeammateListError(t *testing.T) {
+	want := errors.New("boom")
+	_, err := lookupTeammate(context.Background(), "alice@example.com",
+		func(_ context.Context, _ string) (string, error) { return "", errors.New("404 Not Found") },
+		func(_ context.Context, _ string) (string, error) { return "", want },
+	)
+	if !errors.Is(err, want) {
+		t.Errorf("got error %v, want %v", err, want)
+	}
+}
+
 func testAccTeammateResourceConfig(email string, scopes []string) string {
 	for i, s := range scopes {
 		scopes[i] = `"` + s + `"`
@@ -52,3 +578,202 @@ resource "sendgrid_teammate" "test" {
 }
 `, email, strings.Join(scopes, ", "))
 }
+
+func testAccTeammateResourceAdminConfig(email string, scopes []string) string {
+	for i, s := range scopes {
+		scopes[i] = `"` + s + `"`
+	}
+	return fmt.Sprintf(`
+resource "sendgrid_teammate" "test" {
+	email    = "%s"
+	is_admin = true
+	scopes   = [%s]
+}
+`, email, strings.Join(scopes, ", "))
+}
+
+func TestTeammatePermissionsChanged(t *testing.T) {
+	cases := []struct {
+		name           string
+		desiredIsAdmin bool
+		currentIsAdmin bool
+		desired        []string
+		current        []string
+		want           bool
+	}{
+		{name: "unchanged", desired: []string{"mail.send"}, current: []string{"mail.send"}},
+		{name: "reordered", desired: []string{"alerts.read", "mail.send"}, current: []string{"mail.send", "alerts.read"}},
+		{name: "duplicated", desired: []string{"mail.send", "alerts.read", "mail.send"}, current: []string{"alerts.read", "mail.send"}},
+		{name: "added", desired: []string{"mail.send", "alerts.read"}, current: []string{"mail.send"}, want: true},
+		{name: "removed", desired: []string{}, current: []string{"mail.send"}, want: true},
+		{name: "promoted", desiredIsAdmin: true, desired: []string{"mail.send"}, current: []string{"mail.send"}, want: true},
+		{name: "admin", desiredIsAdmin: true, currentIsAdmin: true, desired: []string{}, current: []string{"mail.send"}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := teammatePermissionsChanged(c.desiredIsAdmin, c.currentIsAdmin, c.desired, c.current); got != c.want {
+				t.Errorf("got %t, want %t", got, c.want)
+			}
+		})
+	}
+}
+
+func TestTeammateResourceUpdateSkipsUnchangedScopes(t *testing.T) {
+	ctx := context.Background()
+
+	rt := newMockTransport()
+	rt.Handle(http.MethodGet, "/v3/teammates/pending", func(_ *http.Request) (int, any) {
+		return http.StatusOK, map[string]any{"result": []any{}}
+	})
+	updates := 0
+	rt.Handle(http.MethodPatch, "/v3/teammates/teammate", func(_ *http.Request) (int, any) {
+		updates++
+		return http.StatusOK, map[string]any{"username": "teammate", "email": "teammate@example.com", "scopes": []string{"mail.send", "alerts.read"}}
+	})
+	r := &teammateResource{client: newMockClient(rt)}
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	s := schemaResp.Schema
+
+	value := func(scopes ...string) tftypes.Value {
+		elems := []tftypes.Value{}
+		for _, scope := range scopes {
+			elems = append(elems, tftypes.NewValue(tftypes.String, scope))
+		}
+		return tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"id":       tftypes.NewValue(tftypes.String, "teammate@example.com"),
+			"email":    tftypes.NewValue(tftypes.String, "teammate@example.com"),
+			"is_admin": tftypes.NewValue(tftypes.Bool, false),
+			"scopes":   tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, elems),
+			"role":     tftypes.NewValue(tftypes.String, nil),
+			"username": tftypes.NewValue(tftypes.String, "teammate"),
+
+			"report_implied_scopes": tftypes.NewValue(tftypes.Bool, false),
+		})
+	}
+
+	// The configuration was refactored, but the effective scope set is the same.
+	resp := &fwresource.UpdateResponse{
+		State: tfsdk.State{Schema: s, Raw: value("mail.send", "alerts.read")},
+	}
+	r.Update(ctx, fwresource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: s, Raw: value("alerts.read", "mail.send")},
+		State: tfsdk.State{Schema: s, Raw: value("mail.send", "alerts.read")},
+	}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if updates != 0 {
+		t.Errorf("got %d permission updates, want none", updates)
+	}
+	var got teammateResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	if got.Username.ValueString() != "teammate" || len(got.Scopes) != 2 {
+		t.Errorf("got %+v, want the username and both scopes kept", got)
+	}
+}
+
+func TestImpliedTeammateScopes(t *testing.T) {
+	cases := []struct {
+		name       string
+		configured []string
+		remote     []string
+		want       []string
+	}{
+		{
+			name:       "implied scopes are reported once",
+			configured: []string{"mail.send", "mail.batch.create"},
+			remote:     []string{"mail.send", "mail.batch.create", "mail.batch.read"},
+			want:       []string{"mail.batch.read"},
+		},
+		{
+			name:       "implied scope configured explicitly is not reported",
+			configured: []string{"mail.send", "mail.batch.read"},
+			remote:     []string{"mail.send", "mail.batch.read"},
+			want:       []string{},
+		},
+		{
+			name:       "scopes not implied by the configuration are not reported",
+			configured: []string{"user.profile.read"},
+			remote:     []string{"user.profile.read", "templates.read", "2fa_required"},
+			want:       []string{},
+		},
+		{
+			name:       "extra scopes are sorted",
+			configured: []string{"templates.create", "alerts.update"},
+			remote:     []string{"templates.create", "templates.read", "alerts.update", "alerts.read"},
+			want:       []string{"alerts.read", "templates.read"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var configured []types.String
+			for _, s := range c.configured {
+				configured = append(configured, types.StringValue(s))
+			}
+
+			if got := impliedTeammateScopes(configured, c.remote); !slices.Equal(got, c.want) {
+				t.Errorf("got %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestTeammateResourceReadReportsImpliedScopes(t *testing.T) {
+	ctx := context.Background()
+
+	rt := newMockTransport()
+	rt.Handle(http.MethodGet, "/v3/teammates/pending", func(_ *http.Request) (int, any) {
+		return http.StatusOK, map[string]any{"result": []any{}}
+	})
+	rt.Handle(http.MethodGet, "/v3/teammates", func(_ *http.Request) (int, any) {
+		return http.StatusOK, map[string]any{"result": []any{
+			map[string]any{"username": "teammate", "email": "teammate@example.com"},
+		}}
+	})
+	rt.Handle(http.MethodGet, "/v3/teammates/teammate", func(_ *http.Request) (int, any) {
+		return http.StatusOK, map[string]any{"username": "teammate", "email": "teammate@example.com", "scopes": []string{"mail.send", "mail.batch.read"}}
+	})
+	r := &teammateResource{client: newMockClient(rt)}
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	s := schemaResp.Schema
+
+	for _, report := range []bool{false, true} {
+		t.Run(fmt.Sprintf("report_implied_scopes=%t", report), func(t *testing.T) {
+			state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"id":       tftypes.NewValue(tftypes.String, "teammate@example.com"),
+				"email":    tftypes.NewValue(tftypes.String, "teammate@example.com"),
+				"is_admin": tftypes.NewValue(tftypes.Bool, false),
+				"scopes": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "mail.send"),
+				}),
+				"role":     tftypes.NewValue(tftypes.String, nil),
+				"username": tftypes.NewValue(tftypes.String, "teammate"),
+
+				"report_implied_scopes": tftypes.NewValue(tftypes.Bool, report),
+			})}
+			resp := &fwresource.ReadResponse{State: state}
+			r.Read(ctx, fwresource.ReadRequest{State: state}, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			want := 0
+			if report {
+				want = 1
+			}
+			if got := resp.Diagnostics.WarningsCount(); got != want {
+				t.Fatalf("got %d warnings, want %d: %v", got, want, resp.Diagnostics)
+			}
+			if report && !strings.Contains(resp.Diagnostics.Warnings()[0].Detail(), "mail.batch.read") {
+				t.Errorf("got %q, want the implied scope listed", resp.Diagnostics.Warnings()[0].Detail())
+			}
+		})
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/i10416/sendgrid"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &teammatesDataSource{}
+	_ datasource.DataSourceWithConfigure = &teammatesDataSource{}
+)
+
+func newTeammatesDataSource() datasource.DataSource {
+	return &teammatesDataSource{}
+}
+
+type teammatesDataSource struct {
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
+}
+
+type teammatesDataSourceModel struct {
+	ID        types.String                   `tfsdk:"id"`
+	Scope     types.String                   `tfsdk:"scope"`
+	Teammates []teammatesDataSourceItemModel `tfsdk:"teammates"`
+}
+
+type teammatesDataSourceItemModel struct {
+	Email    types.String   `tfsdk:"email"`
+	Username types.String   `tfsdk:"username"`
+	IsAdmin  types.Bool     `tfsdk:"is_admin"`
+	UserType types.String   `tfsdk:"user_type"`
+	Scopes   []types.String `tfsdk:"scopes"`
+}
+
+func (d *teammatesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_teammates"
+}
+
+func (d *teammatesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = data.client
+	d.rateLimits = data.rateLimits
+}
+
+func (d *teammatesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Provides the list of all teammates on the account. Pending teammates who have not accepted their invitation are not included.
+
+This is useful for auditing who has access to the account.
+
+For more detailed information, please see the [SendGrid documentation](https://docs.sendgrid.com/glossary/teammates).
+		`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"scope": schema.StringAttribute{
+				MarkdownDescription: "If set, only teammates having this scope are returned. Administrators have all scopes, so they are always returned.",
+				Optional:            true,
+			},
+			"teammates": schema.ListNestedAttribute{
+				MarkdownDescription: "All teammates on the account, filtered by `scope` if set.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"email": schema.StringAttribute{
+							MarkdownDescription: "Teammate's email",
+							Computed:            true,
+						},
+						"username": schema.StringAttribute{
+							MarkdownDescription: "Teammate's username",
+							Computed:            true,
+						},
+						"is_admin": schema.BoolAttribute{
+							MarkdownDescription: "Set to true if teammate has admin privileges",
+							Computed:            true,
+						},
+						"user_type": schema.StringAttribute{
+							MarkdownDescription: "Indicate the type of user: account owner, teammate admin user, or normal teammate. Allowed Values: admin, owner, teammate",
+							Computed:            true,
+						},
+						"scopes": schema.SetAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "Scopes associated to teammate. Empty for administrators, who have all scopes.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *teammatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var s teammatesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &s)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teammates, err := paginateAll(ctx, 50, func(ctx context.Context, limit, offset int) ([]sendgrid.Teammate, error) {
+		r, err := d.client.GetTeammates(ctx, &sendgrid.InputGetTeammates{
+			Limit:  limit,
+			Offset: offset,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return r.Teammates, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading teammates",
+			fmt.Sprintf("Unable to get teammates, got error: %s", sendgridErrorDetail(err)),
+		)
+		return
+	}
+
+	items := []teammatesDataSourceItemModel{}
+	for _, t := range teammates {
+		// The list endpoint does not return scopes, so they are read per teammate.
+		user, err := d.client.GetTeammate(ctx, t.Username)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Reading teammates",
+				fmt.Sprintf("Unable to get teammate (username: %s), got error: %s", t.Username, sendgridErrorDetail(err)),
+			)
+			return
+		}
+
+		scopes := []types.String{}
+		// administrators have all scopes, so we don't need to set them.
+		if !user.IsAdmin {
+			for _, s := range user.Scopes {
+				scopes = append(scopes, types.StringValue(s))
+			}
+		}
+
+		items = append(items, teammatesDataSourceItemModel{
+			Email:    types.StringValue(user.Email),
+			Username: types.StringValue(user.Username),
+			IsAdmin:  types.BoolValue(user.IsAdmin),
+			UserType: types.StringValue(user.UserType),
+			Scopes:   scopes,
+		})
+	}
+
+	if scope := s.Scope.ValueString(); scope != "" {
+		items = filterTeammatesByScope(items, scope)
+	}
+
+	s = teammatesDataSourceModel{
+		ID:        types.StringValue("teammates"),
+		Scope:     s.Scope,
+		Teammates: items,
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &s)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// filterTeammatesByScope returns the teammates having scope. Administrators have all scopes.
+func filterTeammatesByScope(items []teammatesDataSourceItemModel, scope string) []teammatesDataSourceItemModel {
+	filtered := []teammatesDataSourceItemModel{}
+	for _, t := range items {
+		if t.IsAdmin.ValueBool() || slices.Contains(t.Scopes, types.StringValue(scope)) {
+			filtered = append(filtered, t)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTeammatesDataSource(t *testing.T) {
+	resourceName := "data.sendgrid_teammates.test"
+
+	email := fmt.Sprintf("test-acc-%s@example.com", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccTeammatesDataSourceConfig(email),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "teammates.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestFilterTeammatesByScope(t *testing.T) {
+	items := []teammatesDataSourceItemModel{
+		{Username: types.StringValue("admin"), IsAdmin: types.BoolValue(true), Scopes: []types.String{}},
+		{Username: types.StringValue("reader"), IsAdmin: types.BoolValue(false), Scopes: []types.String{types.StringValue("mail.send"), types.StringValue("alerts.read")}},
+		{Username: types.StringValue("sender"), IsAdmin: types.BoolValue(false), Scopes: []types.String{types.StringValue("mail.send")}},
+	}
+
+	cases := []struct {
+		scope string
+		want  []string
+	}{
+		{scope: "alerts.read", want: []string{"admin", "reader"}},
+		{scope: "mail.send", want: []string{"admin", "reader", "sender"}},
+		{scope: "api_keys.read", want: []string{"admin"}},
+	}
+
+	for _, c := range cases {
+		got := []string{}
+		for _, tm := range filterTeammatesByScope(items, c.scope) {
+			got = append(got, tm.Username.ValueString())
+		}
+		if fmt.Sprint(got) != fmt.Sprint(c.want) {
+			t.Errorf("filterTeammatesByScope(%q) = %v, want %v", c.scope, got, c.want)
+		}
+	}
+}
+
+func testAccTeammatesDataSourceConfig(email string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_teammate" "test" {
+	email = "%[1]s"
+	scopes = ["user.profile.read"]
+}
+
+data "sendgrid_teammates" "test" {
+	depends_on = [sendgrid_teammate.test]
+}
+`, email)
+}
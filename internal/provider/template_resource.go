@@ -26,7 +26,8 @@ func newTemplateResource() resource.Resource {
 }
 
 type templateResource struct {
-	client *sendgrid.Client
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
 }
 
 type templateResourceModel struct {
@@ -78,18 +79,19 @@ func (r *templateResource) Configure(ctx context.Context, req resource.Configure
 		return
 	}
 
-	client, ok := req.ProviderData.(*sendgrid.Client)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+	r.rateLimits = data.rateLimits
 }
 
 func (r *templateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -99,7 +101,9 @@ func (r *templateResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
+	ctx = withAPILogFields(ctx, "create", plan.ID)
+
+	res, err := r.rateLimits.retryNonIdempotent(ctx, rateLimitGroupTemplates, func() (interface{}, error) {
 		return r.client.CreateTemplate(ctx, &sendgrid.InputCreateTemplate{
 			Name:       plan.Name.ValueString(),
 			Generation: plan.Generation.ValueString(),
@@ -108,7 +112,7 @@ func (r *templateResource) Create(ctx context.Context, req resource.CreateReques
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Creating template",
-			fmt.Sprintf("Unable to create template, got error: %s", err),
+			fmt.Sprintf("Unable to create template, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -140,12 +144,14 @@ func (r *templateResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "read", state.ID)
+
 	id := state.ID.ValueString()
 	o, err := r.client.GetTemplate(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading template",
-			fmt.Sprintf("Unable to read template (id: %v), got error: %s", id, err),
+			fmt.Sprintf("Unable to read template (id: %v), got error: %s", id, sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -169,6 +175,8 @@ func (r *templateResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "update", data.ID)
+
 	id := state.ID.ValueString()
 	o, err := r.client.UpdateTemplate(ctx, id, &sendgrid.InputUpdateTemplate{
 		Name: data.Name.ValueString(),
@@ -176,7 +184,7 @@ func (r *templateResource) Update(ctx context.Context, req resource.UpdateReques
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Updating template",
-			fmt.Sprintf("Unable to update template (id: %v), got error: %s", id, err),
+			fmt.Sprintf("Unable to update template (id: %v), got error: %s", id, sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -199,17 +207,21 @@ func (r *templateResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "delete", state.ID)
+
 	id := state.ID.ValueString()
 	if err := r.client.DeleteTemplate(ctx, id); err != nil {
 		resp.Diagnostics.AddError(
 			"Deleting template",
-			fmt.Sprintf("Unable to delete template (id: %v), got error: %s", id, err),
+			fmt.Sprintf("Unable to delete template (id: %v), got error: %s", id, sendgridErrorDetail(err)),
 		)
 		return
 	}
 }
 
 func (r *templateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = withAPILogFields(ctx, "import", types.StringValue(req.ID))
+
 	var data templateResourceModel
 
 	id := req.ID
@@ -219,7 +231,7 @@ func (r *templateResource) ImportState(ctx context.Context, req resource.ImportS
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Importing template",
-			fmt.Sprintf("Unable to read template, got error: %s", err),
+			fmt.Sprintf("Unable to read template, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -25,7 +25,8 @@ func newTemplateVersionDataSource() datasource.DataSource {
 }
 
 type templateVersionDataSource struct {
-	client *sendgrid.Client
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
 }
 
 type templateVersionDataSourceModel struct {
@@ -52,16 +53,17 @@ func (d *templateVersionDataSource) Configure(ctx context.Context, req datasourc
 		return
 	}
 
-	client, ok := req.ProviderData.(*sendgrid.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = data.client
+	d.rateLimits = data.rateLimits
 }
 
 func (d *templateVersionDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
@@ -136,7 +138,7 @@ func (d *templateVersionDataSource) Read(ctx context.Context, req datasource.Rea
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading template version",
-			fmt.Sprintf("Unable to get template version, got error: %s", err),
+			fmt.Sprintf("Unable to get template version, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
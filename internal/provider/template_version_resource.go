@@ -30,7 +30,8 @@ func newTemplateVersionResource() resource.Resource {
 }
 
 type templateVersionResource struct {
-	client *sendgrid.Client
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
 }
 
 type templateVersionResourceModel struct {
@@ -134,18 +135,19 @@ func (r *templateVersionResource) Configure(ctx context.Context, req resource.Co
 		return
 	}
 
-	client, ok := req.ProviderData.(*sendgrid.Client)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+	r.rateLimits = data.rateLimits
 }
 
 func (r *templateVersionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -155,6 +157,8 @@ func (r *templateVersionResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "create", plan.ID)
+
 	templateID := plan.TemplateID.ValueString()
 
 	active, _ := plan.Active.ValueBigFloat().Int64()
@@ -175,13 +179,13 @@ func (r *templateVersionResource) Create(ctx context.Context, req resource.Creat
 		input.PlainContent = plan.PlainContent.ValueString()
 	}
 
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
+	res, err := r.rateLimits.retryNonIdempotent(ctx, rateLimitGroupTemplates, func() (interface{}, error) {
 		return r.client.CreateTemplateVersion(ctx, templateID, input)
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Creating template version",
-			fmt.Sprintf("Unable to create template version (template id: %s), got error: %s", templateID, err),
+			fmt.Sprintf("Unable to create template version (template id: %s), got error: %s", templateID, sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -221,13 +225,15 @@ func (r *templateVersionResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "read", state.ID)
+
 	versionID := state.ID.ValueString()
 	templateID := state.TemplateID.ValueString()
 	o, err := r.client.GetTemplateVersion(ctx, templateID, versionID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading template version",
-			fmt.Sprintf("Unable to read template version (template id: %s, version id: %s), got error: %s", templateID, versionID, err),
+			fmt.Sprintf("Unable to read template version (template id: %s, version id: %s), got error: %s", templateID, versionID, sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -259,6 +265,8 @@ func (r *templateVersionResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "update", data.ID)
+
 	input := &sendgrid.InputUpdateTemplateVersion{}
 
 	active, _ := data.Active.ValueBigFloat().Int64()
@@ -292,7 +300,7 @@ func (r *templateVersionResource) Update(ctx context.Context, req resource.Updat
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Updating template version",
-			fmt.Sprintf("Unable to update template version (template id: %s, version id: %s), got error: %s", templateID, versionID, err),
+			fmt.Sprintf("Unable to update template version (template id: %s, version id: %s), got error: %s", templateID, versionID, sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -323,18 +331,22 @@ func (r *templateVersionResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "delete", state.ID)
+
 	versionID := state.ID.ValueString()
 	templateID := state.TemplateID.ValueString()
 	if err := r.client.DeleteTemplateVersion(ctx, templateID, versionID); err != nil {
 		resp.Diagnostics.AddError(
 			"Deleting template version",
-			fmt.Sprintf("Unable to delete template version (template id: %s, version id: %s), got error: %s", templateID, versionID, err),
+			fmt.Sprintf("Unable to delete template version (template id: %s, version id: %s), got error: %s", templateID, versionID, sendgridErrorDetail(err)),
 		)
 		return
 	}
 }
 
 func (r *templateVersionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = withAPILogFields(ctx, "import", types.StringValue(req.ID))
+
 	var data templateVersionResourceModel
 
 	id := req.ID
@@ -356,7 +368,7 @@ func (r *templateVersionResource) ImportState(ctx context.Context, req resource.
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Importing template version",
-			fmt.Sprintf("Unable to read template version (template id: %s, version id: %s), got error: %s", templateID, versionID, err),
+			fmt.Sprintf("Unable to read template version (template id: %s, version id: %s), got error: %s", templateID, versionID, sendgridErrorDetail(err)),
 		)
 		return
 	}
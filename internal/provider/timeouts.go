@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultOperationTimeout bounds an operation whose timeout is not configured, matching Terraform's SDK default.
+const defaultOperationTimeout = 20 * time.Minute
+
+// timeoutsAttrTypes are the attribute types of the timeouts block, which accepts a timeout for every operation.
+var timeoutsAttrTypes = map[string]attr.Type{
+	"create": types.StringType,
+	"read":   types.StringType,
+	"update": types.StringType,
+	"delete": types.StringType,
+}
+
+// timeoutsBlock returns the timeouts block accepting a duration for each operation.
+func timeoutsBlock(ctx context.Context) schema.Block {
+	description := func(op string) string {
+		return "How long to wait for the " + op + " operation, including rate limit retries, as a duration such as `30s` or `2h45m`. Defaults to `" + defaultOperationTimeout.String() + "`."
+	}
+	return timeouts.Block(ctx, timeouts.Opts{
+		Create:            true,
+		Read:              true,
+		Update:            true,
+		Delete:            true,
+		CreateDescription: description("create"),
+		ReadDescription:   description("read"),
+		UpdateDescription: description("update"),
+		DeleteDescription: description("delete"),
+	})
+}
+
+// nullTimeouts is the timeouts block of a resource that was imported, where no timeouts are configured.
+func nullTimeouts() timeouts.Value {
+	return timeouts.Value{
+		Object: types.ObjectNull(timeoutsAttrTypes),
+	}
+}
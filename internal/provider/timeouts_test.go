@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/i10416/sendgrid"
+)
+
+func testTimeouts(t *testing.T, create string) timeouts.Value {
+	t.Helper()
+
+	v := types.StringNull()
+	if create != "" {
+		v = types.StringValue(create)
+	}
+	o, diags := types.ObjectValue(timeoutsAttrTypes, map[string]attr.Value{
+		"create": v,
+		"read":   types.StringNull(),
+		"update": types.StringNull(),
+		"delete": types.StringNull(),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	return timeouts.Value{Object: o}
+}
+
+func TestConfiguredTimeout(t *testing.T) {
+	ctx := context.Background()
+
+	cases := []struct {
+		name     string
+		timeouts timeouts.Value
+		get      func(timeouts.Value) (time.Duration, diag.Diagnostics)
+		want     time.Duration
+	}{
+		{
+			name:     "no block",
+			timeouts: nullTimeouts(),
+			get: func(v timeouts.Value) (time.Duration, diag.Diagnostics) {
+				return v.Create(ctx, defaultOperationTimeout)
+			},
+			want: defaultOperationTimeout,
+		},
+		{
+			name:     "configured",
+			timeouts: testTimeouts(t, "90s"),
+			get: func(v timeouts.Value) (time.Duration, diag.Diagnostics) {
+				return v.Create(ctx, defaultOperationTimeout)
+			},
+			want: 90 * time.Second,
+		},
+		{
+			name:     "other operation",
+			timeouts: testTimeouts(t, "90s"),
+			get: func(v timeouts.Value) (time.Duration, diag.Diagnostics) {
+				return v.Delete(ctx, defaultOperationTimeout)
+			},
+			want: defaultOperationTimeout,
+		},
+		{
+			name:     "not configured",
+			timeouts: testTimeouts(t, ""),
+			get: func(v timeouts.Value) (time.Duration, diag.Diagnostics) {
+				return v.Create(ctx, defaultOperationTimeout)
+			},
+			want: defaultOperationTimeout,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, diags := c.get(c.timeouts)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if got != c.want {
+				t.Errorf("got %s, want %s", got, c.want)
+			}
+		})
+	}
+}
+
+func TestConfiguredTimeoutAbortsRetryPromptly(t *testing.T) {
+	b := newRateLimitBuckets()
+
+	createTimeout, diags := testTimeouts(t, "100ms").Create(context.Background(), defaultOperationTimeout)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), createTimeout)
+	defer cancel()
+
+	calls := 0
+	start := time.Now()
+	_, err := b.retry(ctx, rateLimitGroupWhitelabel, func() (interface{}, error) {
+		calls++
+		return nil, &sendgrid.RateLimitedError{RetryAfter: time.Minute}
+	})
+	elapsed := time.Since(start)
+
+	if err == nil {
+		t.Fatal("expected the rate limit error once the timeout is reached")
+	}
+	if calls != 1 {
+		t.Errorf("got %d calls, want 1", calls)
+	}
+	if elapsed > time.Second {
+		t.Errorf("retry took %s, want it to stop at the configured timeout", elapsed)
+	}
+}
+
+func TestResourcesHaveTimeoutsBlock(t *testing.T) {
+	resources := map[string]resource.Resource{
+		"sender_authentication": newSenderAuthenticationResource(),
+		"link_branding":         newLinkBrandingResource(),
+		"reverse_dns":           newReverseDNSResource(),
+		"sender_verification":   newSenderVerificationResource(),
+	}
+
+	for name, r := range resources {
+		t.Run(name, func(t *testing.T) {
+			resp := &resource.SchemaResponse{}
+			r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+			b, ok := resp.Schema.Blocks["timeouts"]
+			if !ok {
+				t.Fatal("the schema has no timeouts block")
+			}
+			got := b.Type().TerraformType(context.Background())
+			want := types.ObjectType{AttrTypes: timeoutsAttrTypes}.TerraformType(context.Background())
+			if !got.Equal(want) {
+				t.Errorf("got timeouts block type %s, want %s", got, want)
+			}
+		})
+	}
+}
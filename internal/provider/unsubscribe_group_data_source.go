@@ -25,7 +25,8 @@ func newUnsubscribeGroupDataSource() datasource.DataSource {
 }
 
 type unsubscribeGroupDataSource struct {
-	client *sendgrid.Client
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
 }
 
 type unsubscribeGroupDataSourceModel struct {
@@ -45,16 +46,17 @@ func (d *unsubscribeGroupDataSource) Configure(ctx context.Context, req datasour
 		return
 	}
 
-	client, ok := req.ProviderData.(*sendgrid.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = data.client
+	d.rateLimits = data.rateLimits
 }
 
 func (d *unsubscribeGroupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
@@ -101,7 +103,7 @@ func (d *unsubscribeGroupDataSource) Read(ctx context.Context, req datasource.Re
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading unsubscribe group",
-			fmt.Sprintf("Unable to get unsubscribe group, got error: %s", err),
+			fmt.Sprintf("Unable to get unsubscribe group, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -25,7 +25,8 @@ func newUnsubscribeGroupResource() resource.Resource {
 }
 
 type unsubscribeGroupResource struct {
-	client *sendgrid.Client
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
 }
 
 type unsubscribeGroupResourceModel struct {
@@ -76,18 +77,19 @@ func (r *unsubscribeGroupResource) Configure(ctx context.Context, req resource.C
 		return
 	}
 
-	client, ok := req.ProviderData.(*sendgrid.Client)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+	r.rateLimits = data.rateLimits
 }
 
 func (r *unsubscribeGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -97,7 +99,9 @@ func (r *unsubscribeGroupResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
+	ctx = withAPILogFields(ctx, "create", plan.ID)
+
+	res, err := r.rateLimits.retryNonIdempotent(ctx, rateLimitGroupASM, func() (interface{}, error) {
 		return r.client.CreateSuppressionGroup(ctx, &sendgrid.InputCreateSuppressionGroup{
 			Name:        plan.Name.ValueString(),
 			Description: plan.Description.ValueString(),
@@ -107,7 +111,7 @@ func (r *unsubscribeGroupResource) Create(ctx context.Context, req resource.Crea
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Creating unsubscribe group",
-			fmt.Sprintf("Unable to create unsubscribe group, got error: %s", err),
+			fmt.Sprintf("Unable to create unsubscribe group, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -140,6 +144,8 @@ func (r *unsubscribeGroupResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "read", state.ID)
+
 	groupID := state.ID.ValueString()
 	id, _ := strconv.ParseInt(groupID, 10, 64)
 
@@ -147,7 +153,7 @@ func (r *unsubscribeGroupResource) Read(ctx context.Context, req resource.ReadRe
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading unsubscribe group",
-			fmt.Sprintf("Unable to read unsubscribe group (id: %v), got error: %s", id, err),
+			fmt.Sprintf("Unable to read unsubscribe group (id: %v), got error: %s", id, sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -172,6 +178,8 @@ func (r *unsubscribeGroupResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "update", data.ID)
+
 	groupID := state.ID.ValueString()
 	id, _ := strconv.ParseInt(groupID, 10, 64)
 
@@ -183,7 +191,7 @@ func (r *unsubscribeGroupResource) Update(ctx context.Context, req resource.Upda
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Updating unsubscribe group",
-			fmt.Sprintf("Unable to update unsubscribe group (id: %v), got error: %s", id, err),
+			fmt.Sprintf("Unable to update unsubscribe group (id: %v), got error: %s", id, sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -207,19 +215,23 @@ func (r *unsubscribeGroupResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
+	ctx = withAPILogFields(ctx, "delete", state.ID)
+
 	groupID := state.ID.ValueString()
 	id, _ := strconv.ParseInt(groupID, 10, 64)
 
 	if err := r.client.DeleteSuppressionGroup(ctx, id); err != nil {
 		resp.Diagnostics.AddError(
 			"Deleting unsubscribe group",
-			fmt.Sprintf("Unable to delete unsubscribe group (id: %v), got error: %s", id, err),
+			fmt.Sprintf("Unable to delete unsubscribe group (id: %v), got error: %s", id, sendgridErrorDetail(err)),
 		)
 		return
 	}
 }
 
 func (r *unsubscribeGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = withAPILogFields(ctx, "import", types.StringValue(req.ID))
+
 	var data unsubscribeGroupResourceModel
 
 	groupID := req.ID
@@ -231,7 +243,7 @@ func (r *unsubscribeGroupResource) ImportState(ctx context.Context, req resource
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Importing unsubscribe group",
-			fmt.Sprintf("Unable to read unsubscribe group, got error: %s", err),
+			fmt.Sprintf("Unable to read unsubscribe group, got error: %s", sendgridErrorDetail(err)),
 		)
 		return
 	}
@@ -0,0 +1,331 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/i10416/sendgrid"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &usageNotificationResource{}
+var _ resource.ResourceWithImportState = &usageNotificationResource{}
+
+// usageNotificationAlertType is the alert type backing usage notifications.
+const usageNotificationAlertType = "usage_limit"
+
+var usageNotificationEmailValidator = stringvalidator.RegexMatches(
+	regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`),
+	"must be an email address",
+)
+
+func newUsageNotificationResource() resource.Resource {
+	return &usageNotificationResource{}
+}
+
+type usageNotificationResource struct {
+	client     *sendgrid.Client
+	rateLimits *rateLimitBuckets
+}
+
+type usageNotificationResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	EmailTo    types.String `tfsdk:"email_to"`
+	Percentage types.Int64  `tfsdk:"percentage"`
+}
+
+func (r *usageNotificationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_usage_notification"
+}
+
+func (r *usageNotificationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Twilio SendGrid's usage notifications email you when your email usage reaches a percentage of your plan's limit.
+
+A usage notification is an alert of type ` + "`usage_limit`" + `. Use ` + "`sendgrid_alert`" + ` for stats notifications.
+		`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the usage notification alert",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email_to": schema.StringAttribute{
+				MarkdownDescription: "The email address the usage notification will be sent to. Example: test@example.com",
+				Required:            true,
+				Validators: []validator.String{
+					usageNotificationEmailValidator,
+				},
+			},
+			"percentage": schema.Int64Attribute{
+				MarkdownDescription: "The percentage of email usage, between 1 and 100, that must be reached before the notification is sent.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+		},
+	}
+}
+
+func (r *usageNotificationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+	r.rateLimits = data.rateLimits
+}
+
+func (r *usageNotificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan usageNotificationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAPILogFields(ctx, "create", plan.ID)
+
+	// NOTE: Re-execute after the re-executable time has elapsed when a rate limit occurs
+	res, err := r.rateLimits.retryNonIdempotent(ctx, rateLimitGroupAlerts, func() (interface{}, error) {
+		return r.client.CreateAlert(ctx, &sendgrid.InputCreateAlert{
+			Type:       usageNotificationAlertType,
+			EmailTo:    plan.EmailTo.ValueString(),
+			Percentage: plan.Percentage.ValueInt64(),
+		})
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Creating usage notification",
+			fmt.Sprintf("Unable to create usage notification, got error: %s", sendgridErrorDetail(err)),
+		)
+		return
+	}
+
+	o, ok := res.(*sendgrid.OutputCreateAlert)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Creating usage notification",
+			"Failed to assert type *sendgrid.OutputCreateAlert",
+		)
+		return
+	}
+
+	plan = usageNotificationResourceModel{
+		ID:         types.StringValue(strconv.FormatInt(o.ID, 10)),
+		EmailTo:    types.StringValue(o.EmailTo),
+		Percentage: types.Int64Value(o.Percentage),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *usageNotificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state usageNotificationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAPILogFields(ctx, "read", state.ID)
+
+	id := state.ID.ValueString()
+	idInt64, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading usage notification",
+			fmt.Sprintf("Unable to read usage notification (id: %s), got error: %s", id, sendgridErrorDetail(err)),
+		)
+		return
+	}
+
+	o, err := r.client.GetAlert(ctx, idInt64)
+	if isNotFoundError(err) {
+		resp.Diagnostics.AddWarning(
+			"Reading usage notification",
+			fmt.Sprintf("Usage notification (id: %s) no longer exists in SendGrid and was removed from state, so it will be recreated.", id),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading usage notification",
+			fmt.Sprintf("Unable to read usage notification (id: %s), got error: %s", id, sendgridErrorDetail(err)),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(id)
+	state.EmailTo = types.StringValue(o.EmailTo)
+	state.Percentage = types.Int64Value(o.Percentage)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *usageNotificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state usageNotificationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAPILogFields(ctx, "update", data.ID)
+
+	id := state.ID.ValueString()
+	idInt64, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Updating usage notification",
+			fmt.Sprintf("Unable to update usage notification, got error: %s", sendgridErrorDetail(err)),
+		)
+		return
+	}
+
+	// NOTE: Re-execute after the re-executable time has elapsed when a rate limit occurs
+	res, err := r.rateLimits.retry(ctx, rateLimitGroupAlerts, func() (interface{}, error) {
+		return r.client.UpdateAlert(ctx, idInt64, &sendgrid.InputUpdateAlert{
+			EmailTo:    data.EmailTo.ValueString(),
+			Percentage: data.Percentage.ValueInt64(),
+		})
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Updating usage notification",
+			fmt.Sprintf("Unable to update usage notification (id: %s), got error: %s", id, sendgridErrorDetail(err)),
+		)
+		return
+	}
+
+	o, ok := res.(*sendgrid.OutputUpdateAlert)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Updating usage notification",
+			"Failed to assert type *sendgrid.OutputUpdateAlert",
+		)
+		return
+	}
+
+	data = usageNotificationResourceModel{
+		ID:         types.StringValue(id),
+		EmailTo:    types.StringValue(o.EmailTo),
+		Percentage: types.Int64Value(o.Percentage),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *usageNotificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state usageNotificationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAPILogFields(ctx, "delete", state.ID)
+
+	id := state.ID.ValueString()
+	idInt64, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Deleting usage notification",
+			fmt.Sprintf("Unable to delete usage notification, got error: %s", sendgridErrorDetail(err)),
+		)
+		return
+	}
+
+	// NOTE: Re-execute after the re-executable time has elapsed when a rate limit occurs
+	_, err = r.rateLimits.retry(ctx, rateLimitGroupAlerts, func() (interface{}, error) {
+		return nil, r.client.DeleteAlert(ctx, idInt64)
+	})
+	// The usage notification is already gone, so there is nothing to delete.
+	if isNotFoundError(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Deleting usage notification",
+			fmt.Sprintf("Unable to delete usage notification (id: %s), got error: %s", id, sendgridErrorDetail(err)),
+		)
+		return
+	}
+}
+
+func (r *usageNotificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = withAPILogFields(ctx, "import", types.StringValue(req.ID))
+
+	id := req.ID
+	idInt64, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Importing usage notification",
+			fmt.Sprintf("Unable to read usage notification, got error: %s", sendgridErrorDetail(err)),
+		)
+		return
+	}
+
+	o, err := r.client.GetAlert(ctx, idInt64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Importing usage notification",
+			fmt.Sprintf("Unable to read usage notification, got error: %s", sendgridErrorDetail(err)),
+		)
+		return
+	}
+
+	if o.Type != usageNotificationAlertType {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Importing usage notification",
+			fmt.Sprintf("Alert (id: %s) has type %q, not %q. Import it as sendgrid_alert instead.", id, o.Type, usageNotificationAlertType),
+		)
+		return
+	}
+
+	data := usageNotificationResourceModel{
+		ID:         types.StringValue(id),
+		EmailTo:    types.StringValue(o.EmailTo),
+		Percentage: types.Int64Value(o.Percentage),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
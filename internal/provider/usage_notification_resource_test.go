@@ -0,0 +1,243 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccUsageNotificationResource(t *testing.T) {
+	resourceName := "sendgrid_usage_notification.test"
+
+	emailTo := fmt.Sprintf("test-acc-%s@example.com", acctest.RandString(16))
+	emailToUpdated := fmt.Sprintf("test-acc-%s@example.com", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccUsageNotificationResourceConfig(emailTo, 90),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "email_to", emailTo),
+					resource.TestCheckResourceAttr(resourceName, "percentage", "90"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccUsageNotificationResourceConfig(emailToUpdated, 80),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "email_to", emailToUpdated),
+					resource.TestCheckResourceAttr(resourceName, "percentage", "80"),
+				),
+			},
+		},
+	})
+}
+
+func TestUsageNotificationResourceWithMockTransport(t *testing.T) {
+	resourceName := "sendgrid_usage_notification.test"
+
+	rt, alerts := newMockAlertTransport()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactoriesWithTransport(rt),
+		CheckDestroy: func(_ *terraform.State) error {
+			if alerts.len() != 0 {
+				return errors.New("usage notification still exists after destroy")
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testUsageNotificationResourceMockConfig("ops@example.com", 90),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "1"),
+					resource.TestCheckResourceAttr(resourceName, "email_to", "ops@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "percentage", "90"),
+					func(_ *terraform.State) error {
+						if typ := alerts.get(1)["type"]; typ != usageNotificationAlertType {
+							return fmt.Errorf("got alert type %v, want %s", typ, usageNotificationAlertType)
+						}
+						return nil
+					},
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update in place testing
+			{
+				Config: testUsageNotificationResourceMockConfig("billing@example.com", 80),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "1"),
+					resource.TestCheckResourceAttr(resourceName, "email_to", "billing@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "percentage", "80"),
+				),
+			},
+		},
+	})
+}
+
+func TestUsageNotificationResourceValidation(t *testing.T) {
+	cases := []struct {
+		name       string
+		emailTo    string
+		percentage int64
+		wantErr    *regexp.Regexp
+	}{
+		{name: "percentage zero", emailTo: "ops@example.com", percentage: 0, wantErr: regexp.MustCompile(`between 1 and 100`)},
+		{name: "percentage over 100", emailTo: "ops@example.com", percentage: 101, wantErr: regexp.MustCompile(`between 1 and 100`)},
+		{name: "invalid email", emailTo: "ops", percentage: 90, wantErr: regexp.MustCompile(`must be an email address`)},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rt, _ := newMockAlertTransport()
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testProtoV6ProviderFactoriesWithTransport(rt),
+				Steps: []resource.TestStep{
+					{
+						Config:      testUsageNotificationResourceMockConfig(c.emailTo, c.percentage),
+						ExpectError: c.wantErr,
+					},
+				},
+			})
+			if n := len(rt.Requests()); n != 0 {
+				t.Errorf("got %d requests, want none", n)
+			}
+		})
+	}
+}
+
+func testAccUsageNotificationResourceConfig(emailTo string, percentage int64) string {
+	return fmt.Sprintf(`
+resource "sendgrid_usage_notification" "test" {
+	email_to   = "%[1]s"
+	percentage = %[2]d
+}
+`, emailTo, percentage)
+}
+
+func testUsageNotificationResourceMockConfig(emailTo string, percentage int64) string {
+	return fmt.Sprintf(`
+provider "sendgrid" {
+	api_key = "SG.test"
+}
+
+resource "sendgrid_usage_notification" "test" {
+	email_to   = "%[1]s"
+	percentage = %[2]d
+}
+`, emailTo, percentage)
+}
+
+// mockAlerts is the in-memory store behind the mock alert endpoints.
+type mockAlerts struct {
+	mu     sync.Mutex
+	nextID int64
+	alerts map[int64]map[string]any
+}
+
+func (a *mockAlerts) len() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return len(a.alerts)
+}
+
+func (a *mockAlerts) get(id int64) map[string]any {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.alerts[id]
+}
+
+// newMockAlertTransport returns a mock transport serving the alert endpoints
+// for the ids 1 to 3, and the store it serves them from.
+func newMockAlertTransport() (*mockTransport, *mockAlerts) {
+	rt := newMockTransport()
+	alerts := &mockAlerts{nextID: 1, alerts: map[int64]map[string]any{}}
+
+	rt.Handle(http.MethodPost, "/v3/alerts", func(req *http.Request) (int, any) {
+		var in map[string]any
+		if err := json.NewDecoder(req.Body).Decode(&in); err != nil {
+			return http.StatusBadRequest, nil
+		}
+
+		alerts.mu.Lock()
+		defer alerts.mu.Unlock()
+		in["id"] = alerts.nextID
+		alerts.alerts[alerts.nextID] = in
+		alerts.nextID++
+		return http.StatusCreated, in
+	})
+
+	for id := int64(1); id <= 3; id++ {
+		p := fmt.Sprintf("/v3/alerts/%d", id)
+		rt.Handle(http.MethodGet, p, func(_ *http.Request) (int, any) {
+			alerts.mu.Lock()
+			defer alerts.mu.Unlock()
+			a, ok := alerts.alerts[id]
+			if !ok {
+				return http.StatusNotFound, nil
+			}
+			return http.StatusOK, a
+		})
+		rt.Handle(http.MethodPatch, p, func(req *http.Request) (int, any) {
+			var in map[string]any
+			if err := json.NewDecoder(req.Body).Decode(&in); err != nil {
+				return http.StatusBadRequest, nil
+			}
+
+			alerts.mu.Lock()
+			defer alerts.mu.Unlock()
+			a, ok := alerts.alerts[id]
+			if !ok {
+				return http.StatusNotFound, nil
+			}
+			for k, v := range in {
+				a[k] = v
+			}
+			return http.StatusOK, a
+		})
+		rt.Handle(http.MethodDelete, p, func(_ *http.Request) (int, any) {
+			alerts.mu.Lock()
+			defer alerts.mu.Unlock()
+			if _, ok := alerts.alerts[id]; !ok {
+				return http.StatusNotFound, nil
+			}
+			delete(alerts.alerts, id)
+			return http.StatusNoContent, nil
+		})
+	}
+
+	return rt, alerts
+}
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// stringPositiveDuration validates that a string is a positive Go duration such as 30s or 2h45m.
+func stringPositiveDuration() validatorStringPositiveDuration {
+	return validatorStringPositiveDuration{}
+}
+
+type validatorStringPositiveDuration struct{}
+
+func (v validatorStringPositiveDuration) Description(ctx context.Context) string {
+	return "Value must be a positive duration such as 30s or 2h45m"
+}
+func (v validatorStringPositiveDuration) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v validatorStringPositiveDuration) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	d, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil || d <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid duration",
+			fmt.Sprintf("Value must be a positive duration such as 30s or 2h45m, got: %q.", req.ConfigValue.ValueString()),
+		)
+		return
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidatorStringPositiveDuration(t *testing.T) {
+	v := stringPositiveDuration()
+
+	cases := []struct {
+		value   types.String
+		wantErr bool
+	}{
+		{value: types.StringValue("30s")},
+		{value: types.StringValue("2h45m")},
+		{value: types.StringNull()},
+		{value: types.StringUnknown()},
+		{value: types.StringValue(""), wantErr: true},
+		{value: types.StringValue("30"), wantErr: true},
+		{value: types.StringValue("0s"), wantErr: true},
+		{value: types.StringValue("-1m"), wantErr: true},
+	}
+
+	for _, c := range cases {
+		resp := &validator.StringResponse{}
+		v.ValidateString(context.Background(), validator.StringRequest{
+			Path:        path.Root("create"),
+			ConfigValue: c.value,
+		}, resp)
+		if got := resp.Diagnostics.HasError(); got != c.wantErr {
+			t.Errorf("ValidateString(%s) error = %t, want %t: %v", c.value, got, c.wantErr, resp.Diagnostics)
+		}
+	}
+}
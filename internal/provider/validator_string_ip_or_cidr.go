@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// stringIPOrCIDR validates that a string is a single IPv4 or IPv6 address, or a CIDR range.
+func stringIPOrCIDR() validatorStringIPOrCIDR {
+	return validatorStringIPOrCIDR{}
+}
+
+type validatorStringIPOrCIDR struct{}
+
+func (v validatorStringIPOrCIDR) Description(ctx context.Context) string {
+	return "Value must be an IPv4 or IPv6 address, or a CIDR range"
+}
+func (v validatorStringIPOrCIDR) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v validatorStringIPOrCIDR) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	if err := parseIPOrCIDR(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IP address or CIDR range",
+			fmt.Sprintf("Value must be an IPv4 or IPv6 address such as 192.0.2.1, or a CIDR range such as 192.0.2.0/24, got: %q. %s.", req.ConfigValue.ValueString(), err),
+		)
+		return
+	}
+}
+
+func parseIPOrCIDR(s string) error {
+	if strings.Contains(s, "/") {
+		_, err := netip.ParsePrefix(s)
+		return err
+	}
+	_, err := netip.ParseAddr(s)
+	return err
+}
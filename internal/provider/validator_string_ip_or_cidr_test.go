@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidatorStringIPOrCIDR(t *testing.T) {
+	v := stringIPOrCIDR()
+
+	cases := []struct {
+		value   types.String
+		wantErr bool
+	}{
+		// IPv4
+		{value: types.StringValue("192.0.2.1")},
+		{value: types.StringValue("0.0.0.0")},
+		// IPv6
+		{value: types.StringValue("2001:db8::1")},
+		{value: types.StringValue("::1")},
+		// CIDR
+		{value: types.StringValue("192.0.2.0/24")},
+		{value: types.StringValue("192.0.2.1/32")},
+		{value: types.StringValue("2001:db8::/32")},
+		{value: types.StringNull()},
+		{value: types.StringUnknown()},
+		// Malformed
+		{value: types.StringValue(""), wantErr: true},
+		{value: types.StringValue("1.2.3"), wantErr: true},
+		{value: types.StringValue("256.0.0.1"), wantErr: true},
+		{value: types.StringValue("example.com"), wantErr: true},
+		{value: types.StringValue(" 192.0.2.1"), wantErr: true},
+		{value: types.StringValue("192.0.2.0/33"), wantErr: true},
+		{value: types.StringValue("192.0.2.0/"), wantErr: true},
+		{value: types.StringValue("2001:db8::/129"), wantErr: true},
+		{value: types.StringValue("2001:db8:::1"), wantErr: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.value.String(), func(t *testing.T) {
+			resp := &validator.StringResponse{}
+			v.ValidateString(context.Background(), validator.StringRequest{
+				Path:        path.Root("ip"),
+				ConfigValue: c.value,
+			}, resp)
+
+			if got := resp.Diagnostics.HasError(); got != c.wantErr {
+				t.Fatalf("got error %t, want %t: %v", got, c.wantErr, resp.Diagnostics)
+			}
+			if c.wantErr {
+				d, ok := resp.Diagnostics.Errors()[0].(interface{ Path() path.Path })
+				if !ok || !d.Path().Equal(path.Root("ip")) {
+					t.Errorf("error is not attached to the ip attribute: %v", resp.Diagnostics)
+				}
+			}
+		})
+	}
+}
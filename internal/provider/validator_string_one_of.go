@@ -17,26 +17,50 @@ func stringOneOf(items ...string) validatorStringOneOf {
 		itemMap[i] = struct{}{}
 	}
 	return validatorStringOneOf{
-		Items: itemMap,
+		Items:  itemMap,
+		values: items,
+	}
+}
+
+// stringOneOfCaseInsensitive is like stringOneOf, but also accepts the items in any case,
+// for values SendGrid matches regardless of case.
+func stringOneOfCaseInsensitive(items ...string) validatorStringOneOf {
+	itemMap := map[string]struct{}{}
+	for _, i := range items {
+		itemMap[strings.ToLower(i)] = struct{}{}
+	}
+	return validatorStringOneOf{
+		Items:           itemMap,
+		values:          items,
+		caseInsensitive: true,
 	}
 }
 
 type validatorStringOneOf struct {
 	Items map[string]struct{}
+	// values keeps the allowed values in the order they were given so that messages are stable.
+	values []string
+	// caseInsensitive is true when Items holds lowercased values to match regardless of case.
+	caseInsensitive bool
 }
 
-func (v validatorStringOneOf) keys() (out []string) {
-	for k := range v.Items {
-		out = append(out, k)
+func (v validatorStringOneOf) keys() []string {
+	return v.values
+}
+
+// suffix returns the note appended to messages of a case-insensitive validator.
+func (v validatorStringOneOf) suffix() string {
+	if v.caseInsensitive {
+		return " (case-insensitive)"
 	}
-	return
+	return ""
 }
 
 func (v validatorStringOneOf) Description(ctx context.Context) string {
-	return fmt.Sprintf("Item must be one of %s", strings.Join(v.keys(), " "))
+	return fmt.Sprintf("Item must be one of %s%s", strings.Join(v.keys(), " "), v.suffix())
 }
 func (v validatorStringOneOf) MarkdownDescription(ctx context.Context) string {
-	return fmt.Sprintf("Item must be one of `%s`", strings.Join(v.keys(), "` `"))
+	return fmt.Sprintf("Item must be one of `%s`%s", strings.Join(v.keys(), "` `"), v.suffix())
 }
 
 func (v validatorStringOneOf) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
@@ -44,11 +68,15 @@ func (v validatorStringOneOf) ValidateString(ctx context.Context, req validator.
 		return
 	}
 
-	if _, ok := v.Items[req.ConfigValue.ValueString()]; !ok {
+	value := req.ConfigValue.ValueString()
+	if v.caseInsensitive {
+		value = strings.ToLower(value)
+	}
+	if _, ok := v.Items[value]; !ok {
 		resp.Diagnostics.AddAttributeError(
 			req.Path,
 			"Invalid value provided",
-			fmt.Sprintf("Item must be one of %s, got: %s.", strings.Join(v.keys(), ", "), req.ConfigValue.ValueString()),
+			fmt.Sprintf("Item must be one of %s%s, got: %q.", strings.Join(v.keys(), ", "), v.suffix(), req.ConfigValue.ValueString()),
 		)
 		return
 	}
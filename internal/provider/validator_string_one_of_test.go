@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidatorStringOneOf(t *testing.T) {
+	v := stringOneOf("text", "number", "date")
+
+	cases := []struct {
+		value   types.String
+		wantErr bool
+	}{
+		{value: types.StringValue("text")},
+		{value: types.StringValue("number")},
+		{value: types.StringValue("date")},
+		{value: types.StringNull()},
+		{value: types.StringUnknown()},
+		{value: types.StringValue("usage_limit"), wantErr: true},
+		{value: types.StringValue(""), wantErr: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.value.String(), func(t *testing.T) {
+			resp := &validator.StringResponse{}
+			v.ValidateString(context.Background(), validator.StringRequest{
+				Path:        path.Root("type"),
+				ConfigValue: c.value,
+			}, resp)
+
+			if got := resp.Diagnostics.HasError(); got != c.wantErr {
+				t.Fatalf("got error %t, want %t: %v", got, c.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestValidatorStringOneOfListsAllowedValues(t *testing.T) {
+	resp := &validator.StringResponse{}
+	stringOneOf("text", "number", "date").ValidateString(context.Background(), validator.StringRequest{
+		Path:        path.Root("type"),
+		ConfigValue: types.StringValue("usage_limit"),
+	}, resp)
+
+	if resp.Diagnostics.ErrorsCount() != 1 {
+		t.Fatalf("got %d errors, want 1", resp.Diagnostics.ErrorsCount())
+	}
+
+	detail := resp.Diagnostics.Errors()[0].Detail()
+	if !strings.Contains(detail, "text, number, date") {
+		t.Errorf("detail %q does not list the allowed values", detail)
+	}
+	if !strings.Contains(detail, "usage_limit") {
+		t.Errorf("detail %q does not mention the given value", detail)
+	}
+}
+
+func TestValidatorStringOneOfCaseInsensitive(t *testing.T) {
+	cases := []struct {
+		value   types.String
+		strict  bool
+		wantErr bool
+	}{
+		{value: types.StringValue("text")},
+		{value: types.StringValue("Text")},
+		{value: types.StringValue("NUMBER")},
+		{value: types.StringNull()},
+		{value: types.StringUnknown()},
+		{value: types.StringValue("usage_limit"), wantErr: true},
+		{value: types.StringValue("Text"), strict: true, wantErr: true},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("%s strict=%t", c.value, c.strict), func(t *testing.T) {
+			v := stringOneOfCaseInsensitive("text", "number", "date")
+			if c.strict {
+				v = stringOneOf("text", "number", "date")
+			}
+
+			resp := &validator.StringResponse{}
+			v.ValidateString(context.Background(), validator.StringRequest{
+				Path:        path.Root("type"),
+				ConfigValue: c.value,
+			}, resp)
+
+			if got := resp.Diagnostics.HasError(); got != c.wantErr {
+				t.Fatalf("got error %t, want %t: %v", got, c.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestValidatorStringOneOfCaseInsensitiveListsAllowedValues(t *testing.T) {
+	resp := &validator.StringResponse{}
+	stringOneOfCaseInsensitive("text", "number", "date").ValidateString(context.Background(), validator.StringRequest{
+		Path:        path.Root("type"),
+		ConfigValue: types.StringValue("Usage_Limit"),
+	}, resp)
+
+	if resp.Diagnostics.ErrorsCount() != 1 {
+		t.Fatalf("got %d errors, want 1", resp.Diagnostics.ErrorsCount())
+	}
+
+	want := `Item must be one of text, number, date (case-insensitive), got: "Usage_Limit".`
+	if got := resp.Diagnostics.Errors()[0].Detail(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
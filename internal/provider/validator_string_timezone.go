@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// stringTimezone validates that a string is a time zone name known to time.LoadLocation such as UTC or Asia/Tokyo.
+func stringTimezone() validatorStringTimezone {
+	return validatorStringTimezone{}
+}
+
+type validatorStringTimezone struct{}
+
+func (v validatorStringTimezone) Description(ctx context.Context) string {
+	return "Value must be an IANA time zone name such as UTC or Asia/Tokyo"
+}
+func (v validatorStringTimezone) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v validatorStringTimezone) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	// NOTE: time.LoadLocation treats an empty name as UTC, which is surprising in configuration.
+	if _, err := time.LoadLocation(req.ConfigValue.ValueString()); err != nil || req.ConfigValue.ValueString() == "" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid time zone",
+			fmt.Sprintf("Value must be an IANA time zone name such as UTC or Asia/Tokyo, got: %q.", req.ConfigValue.ValueString()),
+		)
+		return
+	}
+}
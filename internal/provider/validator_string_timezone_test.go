@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidatorStringTimezone(t *testing.T) {
+	v := stringTimezone()
+
+	cases := []struct {
+		value   types.String
+		wantErr bool
+	}{
+		{value: types.StringValue("UTC")},
+		{value: types.StringValue("Asia/Tokyo")},
+		{value: types.StringValue("America/Los_Angeles")},
+		{value: types.StringNull()},
+		{value: types.StringUnknown()},
+		{value: types.StringValue(""), wantErr: true},
+		{value: types.StringValue("JST+9"), wantErr: true},
+		{value: types.StringValue("Mars/Olympus_Mons"), wantErr: true},
+	}
+
+	for _, c := range cases {
+		resp := &validator.StringResponse{}
+		v.ValidateString(context.Background(), validator.StringRequest{
+			Path:        path.Root("timezone"),
+			ConfigValue: c.value,
+		}, resp)
+		if got := resp.Diagnostics.HasError(); got != c.wantErr {
+			t.Errorf("ValidateString(%s) error = %t, want %t: %v", c.value, got, c.wantErr, resp.Diagnostics)
+		}
+	}
+}